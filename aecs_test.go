@@ -15,8 +15,9 @@ type Vel struct {
 
 func TestArchEngine(t *testing.T) {
 	engine := newArchEngine()
-	writeArch(engine, archetypeId(1), Id(1), Pos{1, 1, 1})
-	pos, ok := readArch[Pos](engine, archetypeId(1), Id(1))
+	archId := engine.GetarchetypeId(C(Pos{}))
+	writeArch(engine, archId, Id(1), Pos{1, 1, 1})
+	pos, ok := readArch[Pos](engine, archId, Id(1))
 	fmt.Println(pos, ok)
 	fmt.Println(engine)
 }
@@ -161,3 +162,28 @@ type d3 struct {
 // 		// list := a.(d1List)
 // 	})
 // }
+
+func TestComponentIdsConcurrent(t *testing.T) {
+	type c1 struct{ v int }
+	type c2 struct{ v int }
+
+	// Multiple worlds resolving component ids in parallel must all agree
+	done := make(chan [2]CompId)
+	for i := 0; i < 8; i++ {
+		go func() {
+			world := NewWorld()
+			id := world.NewId()
+			Write(world, id, C(c1{1}), C(c2{2}))
+			_, ok := Read[c1](world, id)
+			check(t, ok)
+			done <- [2]CompId{nameTyped[c1](), nameTyped[c2]()}
+		}()
+	}
+
+	for i := 0; i < 8; i++ {
+		ids := <-done
+		compare(t, ids[0], name(c1{}))
+		compare(t, ids[1], name(c2{}))
+	}
+	check(t, nameTyped[c1]() != nameTyped[c2]())
+}
@@ -2,35 +2,107 @@ package ecs
 
 import (
 	"fmt"
-	"sync"
+	"math"
 	"reflect"
+	"sync"
+	"unsafe"
 )
 
 // This is the identifier for entities in the world
+// The lower 32 bits hold the index of the entity and the upper 32 bits hold its generation. The generation is incremented every time the world recycles the index, so that stale Ids can't alias newer entities
+//
 //cod:struct
-type Id uint32
+type Id uint64
+
+// Packs an index and a generation into an Id
+func newId(index, generation uint32) Id {
+	return Id(generation)<<32 | Id(index)
+}
+
+// Returns the index portion of the Id
+func (id Id) Index() uint32 {
+	return uint32(id)
+}
+
+// Returns the generation portion of the Id
+func (id Id) Generation() uint32 {
+	return uint32(id >> 32)
+}
 
 type archetypeId uint32
 
+// Component ids are shared by every world. Both maps can be read without locking, the mutex is only used to register new component types
 var componentIdMutex sync.Mutex
-var registeredComponents = make(map[reflect.Type]componentId)
-var invalidComponentId componentId = 0
-var componentRegistryCounter componentId = 1
+var registeredComponents sync.Map // map[reflect.Type]CompId
+var typedComponents sync.Map      // map[typeKey[T]]CompId
+var invalidComponentId CompId = 0
+var componentRegistryCounter CompId = 1
+
+// Describes a registered component
+type compMeta struct {
+	typ         reflect.Type // The Go type of the component, nil for dynamic components
+	name        string
+	size, align uintptr
+}
+
+// Maps a CompId back to its metadata. This is guarded by componentIdMutex
+var compMetas = []compMeta{{}}
+
+// A zero sized key that is unique for every type T, so that nameTyped can find the CompId without reflection
+type typeKey[T any] struct{}
+
+func name(t any) CompId {
+	return nameOfType(reflect.TypeOf(t))
+}
+
+// Returns the CompId of the type, registering it if this is the first time that the type is used as a component
+func nameOfType(typeof reflect.Type) CompId {
+	compId, ok := registeredComponents.Load(typeof)
+	if ok {
+		return compId.(CompId)
+	}
 
-func name(t any) componentId {
-	// Note: We have to lock here in case there are multiple worlds
-	// TODO!! - This probably causes some performance penalty
+	// Note: We have to lock here in case there are multiple worlds registering the same type
 	componentIdMutex.Lock()
 	defer componentIdMutex.Unlock()
 
-	typeof := reflect.TypeOf(t)
-	compId, ok := registeredComponents[typeof]
-	if !ok {
-		compId = componentRegistryCounter
-		registeredComponents[typeof] = compId
-		componentRegistryCounter++
+	compId, ok = registeredComponents.Load(typeof)
+	if ok {
+		return compId.(CompId)
 	}
-	return compId
+	newId := componentRegistryCounter
+	registeredComponents.Store(typeof, newId)
+	compMetas = append(compMetas, compMeta{
+		typ:   typeof,
+		name:  typeof.String(),
+		size:  typeof.Size(),
+		align: uintptr(typeof.Align()),
+	})
+	componentRegistryCounter++
+	return newId
+}
+
+// Returns the metadata of the component, or false if the CompId isn't registered
+func getCompMeta(compId CompId) (compMeta, bool) {
+	componentIdMutex.Lock()
+	defer componentIdMutex.Unlock()
+	if compId == invalidComponentId || int(compId) >= len(compMetas) {
+		return compMeta{}, false
+	}
+	return compMetas[compId], true
+}
+
+// Returns the CompId of type T. This is the same id that name() returns for values of type T, but after the first call it doesn't lock or use reflection
+func nameTyped[T any]() CompId {
+	compId, ok := typedComponents.Load(typeKey[T]{})
+	if ok {
+		return compId.(CompId)
+	}
+
+	var t T
+	newId := name(t)
+	typedComponents.Store(typeKey[T]{}, newId)
+	return newId
 }
 
 type componentSlice[T any] struct {
@@ -50,82 +122,403 @@ func (s *componentSlice[T]) Write(index int, val T) {
 }
 
 type lookupList struct {
-	index map[Id]int // A mapping from entity ids to array indices
-	id    []Id       // An array of every id in the arch list (essentially a reverse mapping from index to Id)
-	holes []int      // List of indexes that have ben deleted
+	id    []Id  // An array of every id in the arch list (essentially a reverse mapping from index to Id)
+	holes []int // List of indexes that have ben deleted
 }
 
-type storage interface {
-	ReadToEntity(*Entity, archetypeId, int) bool
-	ReadToRawEntity(*RawEntity, archetypeId, int) bool
-	Delete(archetypeId, int)
-	print(int)
+// The location of an entity inside of the archetype tables
+type entLoc struct {
+	id     Id // The Id that currently owns this index. After a deletion this holds the Id that the index is reserved for
+	archId archetypeId
+	index  int // The row of the entity inside of its archetype
+	alive  bool
 }
 
-type componentSliceStorage[T any] struct {
-	slice map[archetypeId]*componentSlice[T]
+const (
+	locPageBits = 12
+	locPageSize = 1 << locPageBits
+)
+
+// A sparse array that maps entity indices to their location. Pages are allocated lazily so that large Id ranges don't require the entire array
+type locationIndex struct {
+	pages []*[locPageSize]entLoc
 }
 
-func (ss componentSliceStorage[T]) ReadToEntity(entity *Entity, archId archetypeId, index int) bool {
-	cSlice, ok := ss.slice[archId]
-	if !ok {
-		return false
+// Returns the location slot for the index of the id, or nil if the slot was never allocated
+func (x *locationIndex) slot(id Id) *entLoc {
+	idx := id.Index()
+	p := int(idx >> locPageBits)
+	if p >= len(x.pages) || x.pages[p] == nil {
+		return nil
+	}
+	return &x.pages[p][idx&(locPageSize-1)]
+}
+
+// Returns the location slot for the index of the id, allocating it if necessary
+func (x *locationIndex) slotAlloc(id Id) *entLoc {
+	idx := id.Index()
+	p := int(idx >> locPageBits)
+	if p >= len(x.pages) {
+		x.pages = append(x.pages, make([]*[locPageSize]entLoc, 1+p-len(x.pages))...)
+	}
+	if x.pages[p] == nil {
+		x.pages[p] = new([locPageSize]entLoc)
 	}
-	entity.Add(C(cSlice.comp[index]))
-	return true
+	return &x.pages[p][idx&(locPageSize-1)]
 }
 
-func (ss componentSliceStorage[T]) ReadToRawEntity(entity *RawEntity, archId archetypeId, index int) bool {
-	cSlice, ok := ss.slice[archId]
-	if !ok {
-		return false
+// Returns the location of the entity, or nil if the entity isn't alive
+func (x *locationIndex) get(id Id) *entLoc {
+	loc := x.slot(id)
+	if loc == nil || !loc.alive || loc.id != id {
+		return nil
 	}
-	entity.Add(&cSlice.comp[index])
-	return true
+	return loc
+}
+
+// A column holds the values of a single component type for every row of an archetype
+type column interface {
+	ReadToEntity(*Entity, int)
+	ReadToRawEntity(*RawEntity, int)
+	Delete(int)
+	moveRow(column, int, int)
+	cloneRow(column, int, int)
+	remapRow(int, func(Id) Id)
+	component(int) Component
+	clear()
+	capacityBytes() int
+	pointer(int) any
+	newColumn() column
+	shrink()
+}
+
+func (s *componentSlice[T]) ReadToEntity(entity *Entity, index int) {
+	entity.Add(C(s.comp[index]))
+}
+
+func (s *componentSlice[T]) ReadToRawEntity(entity *RawEntity, index int) {
+	entity.Add(&s.comp[index])
 }
 
-// Delete is somewhat special because it deletes the index of the archId for the componentSlice
+// Delete is somewhat special because it deletes the index of the componentSlice
 // but then plugs the hole by pushing the last element of the componentSlice into index
-func (ss componentSliceStorage[T]) Delete(archId archetypeId, index int) {
-	cSlice, ok := ss.slice[archId]
-	if !ok {
-		return
+func (s *componentSlice[T]) Delete(index int) {
+	lastVal := s.comp[len(s.comp)-1]
+	s.comp[index] = lastVal
+	s.comp = s.comp[:len(s.comp)-1]
+}
+
+// Copies the component at srcIndex of the src column to dstIndex of this column. The src column must hold the same component type
+func (s *componentSlice[T]) moveRow(src column, srcIndex int, dstIndex int) {
+	srcSlice := src.(*componentSlice[T])
+	s.Write(dstIndex, srcSlice.comp[srcIndex])
+}
+
+// Copies the component at srcIndex of the src column to dstIndex of this column, deep copying it if it implements Copier
+func (s *componentSlice[T]) cloneRow(src column, srcIndex int, dstIndex int) {
+	srcSlice := src.(*componentSlice[T])
+	s.Write(dstIndex, copyComponent(srcSlice.comp[srcIndex]))
+}
+
+// Removes every component but keeps the capacity
+func (s *componentSlice[T]) clear() {
+	// Zero the values so that the garbage collector can release anything they point to
+	var zero T
+	for i := range s.comp {
+		s.comp[i] = zero
 	}
+	s.comp = s.comp[:0]
+}
 
-	lastVal := cSlice.comp[len(cSlice.comp)-1]
-	cSlice.comp[index] = lastVal
-	cSlice.comp = cSlice.comp[:len(cSlice.comp)-1]
+// Returns the number of bytes allocated by the column
+func (s *componentSlice[T]) capacityBytes() int {
+	var t T
+	return cap(s.comp) * int(unsafe.Sizeof(t))
 }
 
-func (s componentSliceStorage[T]) print(amount int) {
-	for archId, compSlice := range s.slice {
-		fmt.Printf("archId(%d) - %v\n", archId, *compSlice)
+// Returns a pointer to the component at the index
+func (s *componentSlice[T]) pointer(index int) any {
+	return &s.comp[index]
+}
+
+// Returns the boxed component at the index
+func (s *componentSlice[T]) component(index int) Component {
+	return C(s.comp[index])
+}
+
+// Rewrites the Ids stored in the component at the index, if it implements IdRemapper
+func (s *componentSlice[T]) remapRow(index int, remap func(Id) Id) {
+	remapComponent(&s.comp[index], remap)
+}
+
+// Releases excess capacity of the column
+func (s *componentSlice[T]) shrink() {
+	s.comp = shrinkSlice(s.comp)
+}
+
+// Returns a new, empty column for the same component type
+func (s *componentSlice[T]) newColumn() column {
+	return &componentSlice[T]{
+		comp: make([]T, 0),
 	}
 }
 
-// Provides generic storage for all archetypes
-type archEngine struct {
-	lookup map[archetypeId]*lookupList
+// Returns true if T is a tag component. Tags are zero sized types, like struct{}, which only mark an entity. They don't need any per entity storage
+func isTag[T any]() bool {
+	var t T
+	return unsafe.Sizeof(t) == 0
+}
+
+// The column of a tag component. A tag has no data, so there is nothing to store, copy or delete per entity
+type tagColumn[T any] struct {
+	// Slices of zero sized values don't allocate any memory, so this slice can cover every row of the archetype for free. It lets views read tags like any other component
+	slice componentSlice[T]
+}
+
+func newTagColumn[T any]() *tagColumn[T] {
+	return &tagColumn[T]{
+		slice: componentSlice[T]{
+			comp: make([]T, math.MaxInt32),
+		},
+	}
+}
 
-	compSliceStorage map[componentId]storage
+func (s *tagColumn[T]) ReadToEntity(entity *Entity, index int) {
+	var t T
+	entity.Add(C(t))
+}
+
+func (s *tagColumn[T]) pointer(index int) any {
+	return &s.slice.comp[index]
+}
+
+func (s *tagColumn[T]) component(index int) Component {
+	var t T
+	return C(t)
+}
+
+func (s *tagColumn[T]) ReadToRawEntity(entity *RawEntity, index int) {
+	entity.Add(&s.slice.comp[index])
+}
+
+func (s *tagColumn[T]) Delete(index int)                                {}
+func (s *tagColumn[T]) moveRow(src column, srcIndex int, dstIndex int)  {}
+func (s *tagColumn[T]) cloneRow(src column, srcIndex int, dstIndex int) {}
+func (s *tagColumn[T]) remapRow(index int, remap func(Id) Id)           {}
+func (s *tagColumn[T]) shrink()                                         {}
+func (s *tagColumn[T]) clear()                                          {}
+func (s *tagColumn[T]) capacityBytes() int                              { return 0 }
+
+func (s *tagColumn[T]) newColumn() column {
+	return s // There is no per entity state, so the column can be shared by every archetype
+}
+
+// Returns a new, empty column that can store components of type T
+func newColumn[T any]() column {
+	if isTag[T]() {
+		return newTagColumn[T]()
+	}
+	return &componentSlice[T]{
+		comp: make([]T, 0),
+	}
+}
+
+// Returns the componentSlice that the column reads from
+func columnSlice[T any](col column) *componentSlice[T] {
+	switch c := col.(type) {
+	case *componentSlice[T]:
+		return c
+	case *tagColumn[T]:
+		return &c.slice
+	}
+	panic(fmt.Sprintf("Column doesn't hold component: %T", *new(T)))
+}
+
+// The ticks of when a component was added to a row and when it was last changed. A tick of 0 means that the component wasn't written yet
+// Ticks are only kept for the components that are tracked, see archEngine.track
+type componentTicks struct {
+	added, changed uint64
+}
+
+// Marks the component as changed, and as added if it wasn't written before
+func (t *componentTicks) stamp(tick uint64) {
+	if t.added == 0 {
+		t.added = tick
+	}
+	t.changed = tick
+}
+
+// Returns true if the tick happened after the last tick. Ticks are 64 bits, so they never wrap around
+func tickAfter(tick, last uint64) bool {
+	return tick > last
+}
+
+// An archetype is a table that holds every entity with a specific set of components. Each component gets its own column, and every row represents an entity
+// It also caches the archetypes that are reached by adding or removing a single component
+type archetype struct {
+	id         archetypeId
+	comps      []CompId           // The sorted list of components in this archetype
+	mask       bitset             // The set of components in this archetype
+	columns    []column           // The columns for each component in comps. A column is nil until a value gets written to it
+	ticks      [][]componentTicks // The change ticks of every row, for each component in comps. nil if the component isn't tracked
+	colIndex   []int              // Maps a CompId to its index in comps, or -1 if the archetype doesn't have the component
+	lookup     *lookupList
+	addEdge    map[CompId]archetypeId
+	removeEdge map[CompId]archetypeId
+}
+
+func newArchetype(archId archetypeId, comps []CompId) *archetype {
+	colIndex := make([]int, 0)
+	if len(comps) > 0 {
+		colIndex = make([]int, int(comps[len(comps)-1])+1) // comps is sorted, so the last one is the largest
+	}
+	for i := range colIndex {
+		colIndex[i] = -1
+	}
+	for i, c := range comps {
+		colIndex[c] = i
+	}
+
+	return &archetype{
+		id:       archId,
+		comps:    comps,
+		mask:     newBitset(comps...),
+		columns:  make([]column, len(comps)),
+		ticks:    make([][]componentTicks, len(comps)),
+		colIndex: colIndex,
+		lookup: &lookupList{
+			id:    make([]Id, 0),
+			holes: make([]int, 0),
+		},
+		addEdge:    make(map[CompId]archetypeId),
+		removeEdge: make(map[CompId]archetypeId),
+	}
+}
+
+// Returns the index of the component's column, or -1 if the archetype doesn't have the component
+func (a *archetype) columnIndex(compId CompId) int {
+	if int(compId) >= len(a.colIndex) {
+		return -1
+	}
+	return a.colIndex[compId]
+}
+
+// Appends the ticks of n new rows to every tracked column
+func (a *archetype) appendTicks(n int, t componentTicks) {
+	for i := range a.ticks {
+		if a.ticks[i] == nil {
+			continue // Not tracked
+		}
+		for j := 0; j < n; j++ {
+			a.ticks[i] = append(a.ticks[i], t)
+		}
+	}
+}
 
-	dcr *componentRegistry
+// Deletes the ticks of the row by moving the ticks of the last row into it, just like column.Delete
+func (a *archetype) deleteTicks(index int) {
+	for i, ticks := range a.ticks {
+		if ticks == nil {
+			continue // Not tracked
+		}
+		last := len(ticks) - 1
+		ticks[index] = ticks[last]
+		a.ticks[i] = ticks[:last]
+	}
+}
 
-	// TODO - using this makes things not thread safe inside the engine
-	filterLists []map[archetypeId]bool
+// Returns the change ticks of every row of the component, or nil if the archetype doesn't have the component or it isn't tracked
+func (a *archetype) columnTicks(compId CompId) []componentTicks {
+	idx := a.columnIndex(compId)
+	if idx < 0 {
+		return nil
+	}
+	return a.ticks[idx]
+}
+
+// Stamps the tick on the component in the column and row, if the component is tracked
+func (a *archetype) stamp(colIdx, index int, tick uint64) {
+	if ticks := a.ticks[colIdx]; ticks != nil {
+		ticks[index].stamp(tick)
+	}
+}
+
+// Returns true if the archetype contains the component
+func (a *archetype) has(compId CompId) bool {
+	return a.mask.has(compId)
+}
+
+// Returns the column that holds the component of type T, or nil if there isn't one
+func getColumn[T any](a *archetype, compId CompId) *componentSlice[T] {
+	idx := a.columnIndex(compId)
+	if idx < 0 {
+		return nil
+	}
+	col := a.columns[idx]
+	if col == nil {
+		return nil
+	}
+	return columnSlice[T](col)
+}
+
+// Provides generic storage for all archetypes
+type archEngine struct {
+	dcr        *componentRegistry
+	locs       locationIndex
+	compaction CompactionPolicy
+	sparse     []sparseStorage // Indexed by CompId, nil if the component is stored in archetypes
+	tick       uint64          // The current tick, which gets stamped on every component that is added or changed
 }
 
 func newArchEngine() *archEngine {
 	return &archEngine{
-		lookup:           make(map[archetypeId]*lookupList),
-		compSliceStorage: make(map[componentId]storage),
-		dcr:              newComponentRegistry(),
-		filterLists:      make([]map[archetypeId]bool, 0),
+		dcr:        newComponentRegistry(),
+		compaction: DefaultCompactionPolicy,
+		tick:       1,
+	}
+}
+
+// Starts keeping the change ticks of the component, so that views can filter on it with Added and Changed.
+// The components that already exist don't have any history, so they count as added and changed at the current tick
+func (e *archEngine) track(compId CompId) {
+	if e.tracks(compId) {
+		return
+	}
+	r := e.dcr
+	if int(compId) >= len(r.tracked) {
+		r.tracked = append(r.tracked, make([]bool, 1+int(compId)-len(r.tracked))...)
+	}
+	r.tracked[compId] = true
+
+	for _, arch := range r.archetypes {
+		idx := arch.columnIndex(compId)
+		if idx < 0 {
+			continue
+		}
+		ticks := make([]componentTicks, len(arch.lookup.id))
+		for i := range ticks {
+			ticks[i] = componentTicks{e.tick, e.tick}
+		}
+		arch.ticks[idx] = ticks
+	}
+	if s := e.getSparseStorage(compId); s != nil {
+		s.track(e.tick)
 	}
 }
 
-func (e *archEngine) generation() int {
-	return e.dcr.generation
+// Returns true if the change ticks of the component are kept
+func (e *archEngine) tracks(compId CompId) bool {
+	return e.dcr.tracks(compId)
+}
+
+// Advances the tick and returns it. The tick starts at 1, because a tick of 0 means that a component wasn't written yet
+func (e *archEngine) nextTick() uint64 {
+	e.tick++
+	return e.tick
+}
+
+func (e *archEngine) getArchetype(archId archetypeId) *archetype {
+	return e.dcr.archetypes[archId]
 }
 
 // func (e *archEngine) Print(amount int) {
@@ -148,10 +541,7 @@ func (e *archEngine) count(anything ...any) int {
 
 	total := 0
 	for _, archId := range archIds {
-		lookup, ok := e.lookup[archId]
-		if !ok {
-			panic(fmt.Sprintf("Couldnt find archId in archEngine lookup table: %d", archId))
-		}
+		lookup := e.getArchetype(archId).lookup
 
 		// Each id represents an entity that holds the requested component(s)
 		// Each hole represents a deleted entity that used to hold the requested component(s)
@@ -164,33 +554,6 @@ func (e *archEngine) GetarchetypeId(comp ...Component) archetypeId {
 	return e.dcr.GetarchetypeId(comp...)
 }
 
-// TODO - map might be slower than just having an array. I could probably do a big bitmask and then just do a logical OR
-func (e *archEngine) FilterList(archIds []archetypeId, comp []componentId) []archetypeId {
-	e.filterLists = e.filterLists[:0]
-
-	for _, compId := range comp {
-		e.filterLists = append(e.filterLists, e.dcr.archSet[compId])
-	}
-
-	archIds = archIds[:0]
-	for archId := range e.filterLists[0] {
-		missing := false
-		for i := range e.filterLists {
-			_, exists := e.filterLists[i][archId]
-			if !exists {
-				missing = true
-				break // at least one set was missing
-			}
-		}
-		if !missing {
-			archIds = append(archIds, archId)
-		}
-	}
-
-	return archIds
-}
-
-// TODO!!! - dump this for FilterList
 // Returns the list of archetypeIds that contain all components
 // TODO - this can be optimized
 // var filterLists = make([]map[archetypeId]bool, 0)
@@ -223,114 +586,141 @@ func (e *archEngine) Filter(comp ...any) []archetypeId {
 
 	// return archIds
 
-	lists := make([]map[archetypeId]bool, 0)
+	var mask bitset
 	for i := range comp {
-		n := name(comp[i])
-		lists = append(lists, e.dcr.archSet[n])
+		mask.set(name(comp[i]))
 	}
 
 	archIds := make([]archetypeId, 0)
-	for archId := range lists[0] {
-		missing := false
-		for i := range lists {
-			_, exists := lists[i][archId]
-			if !exists {
-				missing = true
-				break // at least one set was missing
-			}
-		}
-		if !missing {
-			archIds = append(archIds, archId)
+	for _, arch := range e.dcr.archetypes {
+		if arch.mask.containsAll(mask) {
+			archIds = append(archIds, arch.id)
 		}
 	}
 
 	return archIds
 }
 
-func getStorage[T any](e *archEngine) componentSliceStorage[T] {
-	var val T
-	n := name(val)
-	// n := nameGen[T]()
-	ss, ok := e.compSliceStorage[n]
-	if !ok {
-		// TODO - have write call this spot
-		ss = componentSliceStorage[T]{
-			slice: make(map[archetypeId]*componentSlice[T]),
-		}
-		e.compSliceStorage[n] = ss
+// Returns the index of the id inside of the archetype. If the id isn't in the archetype yet, then it gets appended to the end
+func (e *archEngine) allocate(archId archetypeId, id Id) int {
+	loc := e.locs.slotAlloc(id)
+	if loc.alive && loc.id == id && loc.archId == archId {
+		return loc.index
 	}
-	storage := ss.(componentSliceStorage[T])
 
-	return storage
-}
+	// Check if we want to cleanup holes
+	lookup := e.getArchetype(archId).lookup
+	if e.compaction.ShouldCompact(len(lookup.id), len(lookup.holes)) {
+		e.CleanupHoles(archId)
+	}
 
-func writeArch[T any](e *archEngine, archId archetypeId, id Id, val T) {
-	lookup, ok := e.lookup[archId]
-	if !ok {
-		lookup = &lookupList{
-			index: make(map[Id]int),
-			id:    make([]Id, 0),
-			holes: make([]int, 0),
-		}
-		e.lookup[archId] = lookup
+	// Because the Id hasn't been added to this arch, we need to append it to the end
+	lookup.id = append(lookup.id, id)
+	e.getArchetype(archId).appendTicks(1, componentTicks{}) // The ticks get stamped when the components are written
+	*loc = entLoc{
+		id:     id,
+		archId: archId,
+		index:  len(lookup.id) - 1,
+		alive:  true,
 	}
+	return loc.index
+}
 
+// Appends all of the ids to the end of the archetype at once. Returns the index of the first id
+// The ids must not exist in the world yet
+func (e *archEngine) allocateBatch(archId archetypeId, ids []Id) int {
 	// Check if we want to cleanup holes
-	if len(lookup.holes) >= 1024 { // TODO - Hardcoded number, maybe make it percentage based on holes per total entities
+	lookup := e.getArchetype(archId).lookup
+	if e.compaction.ShouldCompact(len(lookup.id), len(lookup.holes)) {
 		e.CleanupHoles(archId)
 	}
 
-	index, ok := lookup.index[id]
-	if !ok {
-		// Because the Id hasn't been added to this arch, we need to append it to the end
-		lookup.id = append(lookup.id, id)
-		index = len(lookup.id) - 1
-		lookup.index[id] = index
+	start := len(lookup.id)
+	lookup.id = append(lookup.id, ids...)
+	e.getArchetype(archId).appendTicks(len(ids), componentTicks{e.tick, e.tick})
+	for i, id := range ids {
+		*e.locs.slotAlloc(id) = entLoc{
+			id:     id,
+			archId: archId,
+			index:  start + i,
+			alive:  true,
+		}
 	}
+	return start
+}
 
-	// Get the componentSliceStorage
-	storage := getStorage[T](e)
-
-	// Get the underlying Archetype's componentSlice
-	cSlice, ok := storage.slice[archId]
-	if !ok {
-		cSlice = &componentSlice[T]{
-			comp: make([]T, 0),
+// Grows the column of the component by n zeroed rows and returns them, so that they can be written in place
+// The column must have exactly start rows before growing, which is the index returned by allocateBatch
+func growColumn[T any](a *archetype, compId CompId, start, n int) []T {
+	colIdx := a.columnIndex(compId)
+	if colIdx < 0 {
+		panic(fmt.Sprintf("Archetype %d doesn't contain component: %T", a.id, *new(T)))
+	}
+	if isTag[T]() {
+		if a.columns[colIdx] == nil {
+			a.columns[colIdx] = newTagColumn[T]()
+		}
+		return columnSlice[T](a.columns[colIdx]).comp[start : start+n]
+	}
+	if a.columns[colIdx] == nil {
+		a.columns[colIdx] = &componentSlice[T]{
+			comp: make([]T, 0, n),
 		}
-		storage.slice[archId] = cSlice
+	}
+	s := a.columns[colIdx].(*componentSlice[T])
+	if len(s.comp) != start {
+		panic("Bug: Column length doesn't match the archetype")
 	}
 
-	cSlice.Write(index, val)
-}
+	if cap(s.comp)-len(s.comp) < n {
+		// Grow the slice once for the entire batch
+		newComp := make([]T, len(s.comp)+n, 2*len(s.comp)+n)
+		copy(newComp, s.comp)
+		s.comp = newComp
+		return s.comp[start:]
+	}
 
-func readArch[T any](e *archEngine, archId archetypeId, id Id) (T, bool) {
-	var ret T
-	lookup, ok := e.lookup[archId]
-	if !ok {
-		return ret, false
+	// The spare capacity may hold old values that were deleted, so it must be zeroed
+	var zero T
+	s.comp = s.comp[:start+n]
+	for i := start; i < len(s.comp); i++ {
+		s.comp[i] = zero
 	}
+	return s.comp[start:]
+}
 
-	index, ok := lookup.index[id]
-	if !ok {
-		return ret, false
+func writeArch[T any](e *archEngine, archId archetypeId, id Id, val T) {
+	index := e.allocate(archId, id)
+
+	// Get the underlying Archetype's componentSlice
+	arch := e.getArchetype(archId)
+	colIdx := arch.columnIndex(nameTyped[T]())
+	if colIdx < 0 {
+		panic(fmt.Sprintf("Archetype %d doesn't contain component: %T", archId, val))
+	}
+	if arch.columns[colIdx] == nil {
+		arch.columns[colIdx] = newColumn[T]()
 	}
+	arch.stamp(colIdx, index, e.tick)
 
-	// Get the dynamic componentSliceStorage
-	n := name(ret)
-	ss, ok := e.compSliceStorage[n]
-	if !ok {
-		return ret, false
+	if isTag[T]() {
+		return // Tags don't store anything
 	}
+	arch.columns[colIdx].(*componentSlice[T]).Write(index, val)
+}
 
-	// fmt.Printf("componentSliceStorage[T] type: %s != %s", name(ss), name(ret))
-	storage, ok := ss.(componentSliceStorage[T])
-	if !ok {
-		panic(fmt.Sprintf("Wrong componentSliceStorage[T] type: %d != %d", name(ss), name(ret)))
+func readArch[T any](e *archEngine, archId archetypeId, id Id) (T, bool) {
+	var ret T
+	loc := e.locs.get(id)
+	if loc == nil || loc.archId != archId {
+		return ret, false
 	}
+	arch := e.getArchetype(archId)
+	index := loc.index
 
 	// Get the underlying Archetype's componentSlice
-	cSlice, ok := storage.slice[archId]
-	if !ok {
+	cSlice := getColumn[T](arch, nameTyped[T]())
+	if cSlice == nil {
 		return ret, false
 	}
 
@@ -338,110 +728,188 @@ func readArch[T any](e *archEngine, archId archetypeId, id Id) (T, bool) {
 }
 
 func readPtrArch[T any](e *archEngine, archId archetypeId, id Id) *T {
-	var ret T
-	lookup, ok := e.lookup[archId]
-	if !ok {
+	loc := e.locs.get(id)
+	if loc == nil || loc.archId != archId {
 		return nil
 	}
+	arch := e.getArchetype(archId)
+	index := loc.index
 
-	index, ok := lookup.index[id]
-	if !ok {
+	// Get the underlying Archetype's componentSlice
+	cSlice := getColumn[T](arch, nameTyped[T]())
+	if cSlice == nil {
 		return nil
 	}
 
-	// Get the dynamic componentSliceStorage
-	n := name(ret)
-	ss, ok := e.compSliceStorage[n]
-	if !ok {
-		return nil
+	return &cSlice.comp[index]
+}
+
+// Removes every entity from every archetype, but keeps the archetypes and the capacity of their columns
+func (e *archEngine) clear() {
+	for _, arch := range e.dcr.archetypes {
+		arch.lookup.id = arch.lookup.id[:0]
+		arch.lookup.holes = arch.lookup.holes[:0]
+		for i := range arch.ticks {
+			arch.ticks[i] = arch.ticks[i][:0]
+		}
+		for _, col := range arch.columns {
+			if col != nil {
+				col.clear()
+			}
+		}
 	}
 
-	// fmt.Printf("componentSliceStorage[T] type: %s != %s", name(ss), name(ret))
-	storage, ok := ss.(componentSliceStorage[T])
-	if !ok {
-		panic(fmt.Sprintf("Wrong componentSliceStorage[T] type: %d != %d", name(ss), name(ret)))
+	for _, s := range e.sparse {
+		if s != nil {
+			s.clear()
+		}
 	}
+}
 
-	// Get the underlying Archetype's componentSlice
-	cSlice, ok := storage.slice[archId]
-	if !ok {
-		return nil
+// Adds the dst entity to the archetype with a copy of every component of the src entity
+func (e *archEngine) cloneArch(archId archetypeId, src, dst Id) {
+	// Note: Allocating may clean up holes and move the src entity, so its index must be read afterwards
+	dstIndex := e.allocate(archId, dst)
+	srcIndex := e.locs.get(src).index
+
+	arch := e.getArchetype(archId)
+	for i, col := range arch.columns {
+		col.cloneRow(col, srcIndex, dstIndex)
+		arch.stamp(i, dstIndex, e.tick)
 	}
+}
 
-	return &cSlice.comp[index]
+// Returns the column and the row of the entity's component, or -1 if the entity doesn't have the component
+func (e *archEngine) componentRow(id Id, compId CompId) (column, int) {
+	loc := e.locs.get(id)
+	if loc == nil {
+		return nil, -1
+	}
+	if s := e.getSparseStorage(compId); s != nil {
+		return s.row(id)
+	}
+
+	arch := e.getArchetype(loc.archId)
+	colIdx := arch.columnIndex(compId)
+	if colIdx < 0 {
+		return nil, -1
+	}
+	return arch.columns[colIdx], loc.index
 }
 
-// TODO - Think: Is it better to read everything then push it into the new archetypeId? Or better to migrate everything in place?
 // Returns the archetypeId of where the entity ends up
 func (e *archEngine) rewriteArch(archId archetypeId, id Id, comp ...Component) archetypeId {
-	// fmt.Println("RewriteArch")
-	ent := e.ReadEntity(archId, id)
-
-	// currentComps := ent.Comps()
-	// fmt.Println("Current", currentComps)
+	newarchetypeId := archId
+	for i := range comp {
+		newarchetypeId = e.dcr.addEdge(newarchetypeId, comp[i].id())
+	}
 
-	ent.Add(comp...)
-	combinedComps := ent.Comps()
-	newarchetypeId := e.GetarchetypeId(combinedComps...)
+	if archId != newarchetypeId {
+		// If the archetype changes, then move the entity's current components over first
+		e.moveArch(archId, newarchetypeId, id)
+	}
 
-	// fmt.Println("archId == newarchetypeId", archId, newarchetypeId)
-	if archId == newarchetypeId {
-		// Case 1: Archetype stays the same
-		for i := range comp {
-			comp[i].write(e, archId, id)
-		}
-	} else {
-		// Case 2: Archetype changes
-		// 1: Delete all components in old archetype
-		// e.DeleteAll(archId, id)
-		e.TagForDeletion(archId, id)
+	// Write the new components, this just overwrites them if the archetype stayed the same
+	for i := range comp {
+		comp[i].write(e, newarchetypeId, id)
+	}
+	return newarchetypeId
+}
 
-		// 2: Write current entity to world
-		for _, c := range ent.comp {
-			c.write(e, newarchetypeId, id)
-		}
-		// 3: Write new components to world
-		for _, c := range comp {
-			c.write(e, newarchetypeId, id)
-		}
+// Removes the specified components from the entity and moves it to the archetype of its remaining components
+// Returns the archetypeId of where the entity ends up
+func (e *archEngine) removeArch(archId archetypeId, id Id, comp ...CompId) archetypeId {
+	newarchetypeId := archId
+	for _, c := range comp {
+		newarchetypeId = e.dcr.removeEdge(newarchetypeId, c)
+	}
 
-		// 4: TODO - Write the new lookupList???
+	if archId != newarchetypeId {
+		e.moveArch(archId, newarchetypeId, id)
 	}
 	return newarchetypeId
 }
 
-func (e *archEngine) ReadEntity(archId archetypeId, id Id) *Entity {
-	lookup, ok := e.lookup[archId]
-	if !ok {
-		panic("Archetype doesn't have lookup list")
+// Moves the entity from the src archetype to the dst archetype, copying every component that both archetypes share
+func (e *archEngine) moveArch(src, dst archetypeId, id Id) {
+	loc := e.locs.get(id)
+	if loc == nil || loc.archId != src {
+		panic("Archetype doesn't contain ID")
+	}
+	srcArch := e.getArchetype(src)
+	srcIndex := loc.index
+
+	// Note: The entity may end up with no components, so we always need to add the id to the new archetype
+	dstIndex := e.allocate(dst, id)
+	dstArch := e.getArchetype(dst)
+
+	// Both component lists are sorted, so we can walk them together to find the shared components
+	i, j := 0, 0
+	for i < len(srcArch.comps) && j < len(dstArch.comps) {
+		if srcArch.comps[i] < dstArch.comps[j] {
+			i++
+		} else if srcArch.comps[i] > dstArch.comps[j] {
+			j++
+		} else {
+			srcCol := srcArch.columns[i]
+			if dstArch.columns[j] == nil {
+				dstArch.columns[j] = srcCol.newColumn()
+			}
+			dstArch.columns[j].moveRow(srcCol, srcIndex, dstIndex)
+			if ticks := dstArch.ticks[j]; ticks != nil {
+				ticks[dstIndex] = srcArch.ticks[i][srcIndex] // Both archetypes belong to the same world, so the component is tracked in both
+			}
+			i++
+			j++
+		}
 	}
 
-	index, ok := lookup.index[id]
-	if !ok {
+	// Note: The location of the entity already points to the dst archetype, so we can't use TagForDeletion
+	e.tagHole(src, srcIndex)
+}
+
+func (e *archEngine) ReadEntity(archId archetypeId, id Id) *Entity {
+	loc := e.locs.get(id)
+	if loc == nil || loc.archId != archId {
 		panic("Archetype doesn't contain ID")
 	}
+	arch := e.getArchetype(archId)
+	index := loc.index
 
 	ent := NewEntity()
-	for n := range e.compSliceStorage {
-		e.compSliceStorage[n].ReadToEntity(ent, archId, index)
+	for _, col := range arch.columns {
+		col.ReadToEntity(ent, index)
+	}
+	for _, s := range e.sparse {
+		if s == nil {
+			continue
+		}
+		if col, sparseIndex := s.row(id); sparseIndex >= 0 {
+			col.ReadToEntity(ent, sparseIndex)
+		}
 	}
 	return ent
 }
 
 func (e *archEngine) ReadRawEntity(archId archetypeId, id Id) *RawEntity {
-	lookup, ok := e.lookup[archId]
-	if !ok {
-		panic("Archetype doesn't have lookup list")
-	}
-
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := e.locs.get(id)
+	if loc == nil || loc.archId != archId {
 		panic("Archetype doesn't contain ID")
 	}
+	arch := e.getArchetype(archId)
+	index := loc.index
 
 	ent := NewRawEntity()
-	for n := range e.compSliceStorage {
-		e.compSliceStorage[n].ReadToRawEntity(ent, archId, index)
+	for _, col := range arch.columns {
+		col.ReadToRawEntity(ent, index)
+	}
+	for _, s := range e.sparse {
+		if s == nil {
+			continue
+		}
+		if col, sparseIndex := s.row(id); sparseIndex >= 0 {
+			col.ReadToRawEntity(ent, sparseIndex)
+		}
 	}
 	return ent
 }
@@ -507,29 +975,28 @@ func (e *archEngine) ReadRawEntity(archId archetypeId, id Id) *RawEntity {
 // Once we get enough holes, we can re-pack the entire slice
 // TODO - How many holes before we repack? How many holes to pack at a time?
 func (e *archEngine) TagForDeletion(archId archetypeId, id Id) {
-	lookup, ok := e.lookup[archId]
-	if !ok {
-		panic("Archetype doesn't have lookup list")
-	}
-
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := e.locs.get(id)
+	if loc == nil || loc.archId != archId {
 		panic("Archetype doesn't contain ID")
 	}
 
+	loc.alive = false
+	e.tagHole(archId, loc.index)
+}
+
+func (e *archEngine) tagHole(archId archetypeId, index int) {
+	lookup := e.getArchetype(archId).lookup
+
 	// This indicates that the index needs to be cleaned up and should be skipped in any list processing
 	lookup.id[index] = InvalidEntity
-	delete(lookup.index, id)
 
 	// This is used to track the current list of indices that need to be cleaned
 	lookup.holes = append(lookup.holes, index)
 }
 
 func (e *archEngine) CleanupHoles(archId archetypeId) {
-	lookup, ok := e.lookup[archId]
-	if !ok {
-		panic("Archetype doesn't have lookup list")
-	}
+	arch := e.getArchetype(archId)
+	lookup := arch.lookup
 	// fmt.Println("Cleaning Holes: ", len(lookup.holes))
 	for _, index := range lookup.holes {
 		// e.DeleteAll(archId, id)
@@ -544,9 +1011,10 @@ func (e *archEngine) CleanupHoles(archId archetypeId) {
 			if lastId == InvalidEntity {
 				// If the last id is a hole, then slice it off
 				lookup.id = lookup.id[:lastIndex]
-				for n := range e.compSliceStorage {
-					e.compSliceStorage[n].Delete(archId, lastIndex)
+				for _, col := range arch.columns {
+					col.Delete(lastIndex)
 				}
+				arch.deleteTicks(lastIndex)
 
 				continue // Try again
 			}
@@ -568,10 +1036,11 @@ func (e *archEngine) CleanupHoles(archId archetypeId) {
 
 		lookup.id[index] = lastId
 		lookup.id = lookup.id[:lastIndex]
-		lookup.index[lastId] = index
-		for n := range e.compSliceStorage {
-			e.compSliceStorage[n].Delete(archId, index)
+		e.locs.slot(lastId).index = index
+		for _, col := range arch.columns {
+			col.Delete(index)
 		}
+		arch.deleteTicks(index)
 	}
 
 	// Clear holes slice
@@ -860,3 +860,35 @@ func BenchmarkRetryNoGenMap(b *testing.B) {
 // func checkSize(ids []Id, pos []Position, vel []Velocity) {
 // 	if len(ids) != len(pos) || len(ids) != len(vel) { panic("ERR") }
 // }
+
+func BenchmarkPhysicsEcsViewMapId(b *testing.B) {
+	world := setupPhysics(1e6)
+	query := Query2[Position, Velocity](world)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query.MapId(physicsTick)
+	}
+}
+
+func BenchmarkPhysicsEcsViewAll(b *testing.B) {
+	world := setupPhysics(1e6)
+	query := Query2[Position, Velocity](world)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for id, row := range query.All() {
+			physicsTick(id, row.A, row.B)
+		}
+	}
+}
+
+func BenchmarkPhysicsEcsViewMapIdParallel(b *testing.B) {
+	world := setupPhysics(1e6)
+	query := Query2[Position, Velocity](world)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query.MapIdParallel(0, physicsTick)
+	}
+}
@@ -0,0 +1,57 @@
+package ecs
+
+// A set of componentIds, stored as one bit per CompId
+type bitset []uint64
+
+func newBitset(comps ...CompId) bitset {
+	var b bitset
+	for _, c := range comps {
+		b.set(c)
+	}
+	return b
+}
+
+// Adds the component to the set
+func (b *bitset) set(c CompId) {
+	word := int(c / 64)
+	if word >= len(*b) {
+		*b = append(*b, make([]uint64, 1+word-len(*b))...)
+	}
+	(*b)[word] |= 1 << (c % 64)
+}
+
+// Returns true if the component is in the set
+func (b bitset) has(c CompId) bool {
+	word := int(c / 64)
+	if word >= len(b) {
+		return false
+	}
+	return b[word]&(1<<(c%64)) != 0
+}
+
+// Returns true if every component in other is also in b
+func (b bitset) containsAll(other bitset) bool {
+	for i, w := range other {
+		if w == 0 {
+			continue
+		}
+		if i >= len(b) || b[i]&w != w {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if at least one component in other is also in b
+func (b bitset) intersects(other bitset) bool {
+	n := len(b)
+	if len(other) < n {
+		n = len(other)
+	}
+	for i := 0; i < n; i++ {
+		if b[i]&other[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
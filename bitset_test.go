@@ -0,0 +1,23 @@
+package ecs
+
+import (
+	"testing"
+)
+
+func TestBitset(t *testing.T) {
+	a := newBitset(1, 5, 130)
+	check(t, a.has(1))
+	check(t, a.has(5))
+	check(t, a.has(130))
+	check(t, !a.has(2))
+	check(t, !a.has(1000))
+
+	check(t, a.containsAll(newBitset(1, 130)))
+	check(t, a.containsAll(newBitset()))
+	check(t, !a.containsAll(newBitset(1, 2)))
+	check(t, !a.containsAll(newBitset(200)))
+
+	check(t, a.intersects(newBitset(2, 130)))
+	check(t, !a.intersects(newBitset(2, 3, 200)))
+	check(t, !a.intersects(newBitset()))
+}
@@ -0,0 +1,41 @@
+package ecs
+
+// Components that implement Copier are deep copied when their entity gets cloned. Components that don't implement it are copied by value, which means that slices, maps and pointers inside of them are shared with the clone
+type Copier[T any] interface {
+	Copy() T
+}
+
+// Returns a copy of the value, using its Copy method if it implements Copier
+func copyComponent[T any](val T) T {
+	if c, ok := any(&val).(Copier[T]); ok {
+		return c.Copy()
+	}
+	return val
+}
+
+// Creates a new entity with a copy of every component of the entity specified at id. The clone goes directly into the same archetype.
+// Returns the Id of the clone, or InvalidEntity if the entity doesn't exist
+func Clone(world *World, id Id) Id {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
+		return InvalidEntity
+	}
+
+	cloneId := world.NewId()
+	archId := loc.archId
+	world.engine.cloneArch(archId, id, cloneId)
+	for _, s := range world.engine.sparse {
+		if s != nil {
+			s.clone(id, cloneId, world.engine.tick)
+		}
+	}
+
+	if len(world.hooks) > 0 {
+		comps := world.engine.getArchetype(archId).comps
+		if len(world.engine.sparse) > 0 {
+			comps = append(world.engine.sparseComps(cloneId), comps...)
+		}
+		world.fireAddHooks([]Id{cloneId}, comps)
+	}
+	return cloneId
+}
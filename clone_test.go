@@ -0,0 +1,69 @@
+package ecs
+
+import (
+	"testing"
+)
+
+type inventory struct {
+	items []string
+}
+
+func (i inventory) Copy() inventory {
+	items := make([]string, len(i.items))
+	copy(items, i.items)
+	return inventory{items}
+}
+
+type shallow struct {
+	items []string
+}
+
+func TestClone(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	id := world.NewId()
+	Write(world, id,
+		C(position{1, 2, 3}),
+		C(inventory{[]string{"sword"}}),
+		C(shallow{[]string{"shield"}}),
+		C(player{}),
+		C(selected{7}),
+	)
+
+	added := 0
+	OnAdd(world, func(id Id, p position) {
+		added++
+	})
+
+	clone := Clone(world, id)
+	check(t, clone != id)
+	check(t, world.IsAlive(clone))
+	compare(t, added, 1)
+	compare(t, world.engine.locs.get(clone).archId, world.engine.locs.get(id).archId)
+
+	pos, ok := Read[position](world, clone)
+	check(t, ok)
+	compare(t, pos, position{1, 2, 3})
+	_, ok = Read[player](world, clone)
+	check(t, ok)
+	sel, ok := Read[selected](world, clone)
+	check(t, ok)
+	compare(t, sel, selected{7})
+
+	// Copiers are deep copied, everything else is copied by value
+	ReadPtr[inventory](world, clone).items[0] = "axe"
+	ReadPtr[shallow](world, clone).items[0] = "buckler"
+	inv, _ := Read[inventory](world, id)
+	compare(t, inv.items[0], "sword")
+	sh, _ := Read[shallow](world, id)
+	compare(t, sh.items[0], "buckler")
+
+	// Modifying the clone doesn't modify the original
+	ReadPtr[position](world, clone).x = 10
+	pos, _ = Read[position](world, id)
+	compare(t, pos, position{1, 2, 3})
+
+	Delete(world, id)
+	compare(t, Clone(world, id), InvalidEntity)
+}
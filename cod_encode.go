@@ -7,9 +7,9 @@ import (
 func (t Id) EncodeCod(bs []byte) []byte {
 
 	{
-		value0 := uint32(t)
+		value0 := uint64(t)
 
-		bs = backend.WriteVarUint32(bs, value0)
+		bs = backend.WriteVarUint64(bs, value0)
 
 	}
 	return bs
@@ -21,9 +21,9 @@ func (t *Id) DecodeCod(bs []byte) (int, error) {
 	var nOff int
 
 	{
-		var value0 uint32
+		var value0 uint64
 
-		value0, nOff, err = backend.ReadVarUint32(bs[n:])
+		value0, nOff, err = backend.ReadVarUint64(bs[n:])
 		if err != nil {
 			return 0, err
 		}
@@ -2,48 +2,72 @@ package ecs
 
 // Represents a list of commands that need to be executed on the world
 type Command struct {
-	world *World
-	list  map[Id]*writeCmd // TODO - Note to self: if you ever add deletion inside of commands, then the packing commands into a map based on entity Id assumption wont hold, because you'll need some amount of specific ordering
+	world  *World
+	list   []cmd            // The commands, in the order that they were added
+	writes map[Id]*writeCmd // The latest write command for each entity, so that consecutive writes can be batched together
 }
 
 // Create a new command to be executed
 func NewCommand(world *World) *Command {
 	return &Command{
-		world: world,
-		list:  make(map[Id]*writeCmd),
+		world:  world,
+		list:   make([]cmd, 0),
+		writes: make(map[Id]*writeCmd),
 	}
 }
 
 // Execute the command
 func (c *Command) Execute() {
-	// TODO - Batch similar commands, if you ever switch to something more complex than just writing
-
 	// Execute all the commands
 	for i := range c.list {
 		c.list[i].execute(c.world)
+		c.list[i] = nil // Release the command so it can be garbage collected
 	}
+	c.list = c.list[:0]
 
 	// Clearing Optimization: https://go.dev/doc/go1.11#performance-compiler
-	for k := range c.list {
-		delete(c.list, k)
+	for k := range c.writes {
+		delete(c.writes, k)
 	}
 }
 
 // TODO - maybe rename as just Write?
 // Adds a write command
 func WriteCmd[A any](c *Command, id Id, comp A) {
-	cmd, ok := c.list[id]
+	cmd, ok := c.writes[id]
 	if !ok {
 		cmd = newWriteCmd(id)
-		c.list[id] = cmd
+		c.writes[id] = cmd
+		c.list = append(c.list, cmd)
 	}
 
 	cmd.comps = append(cmd.comps, C(comp))
 }
 
-// type cmd interface {
-// 	execute(*World)
-// }
+// Adds a command to remove the component of type A from the entity
+func RemoveCmd[A any](c *Command, id Id) {
+	c.list = append(c.list, removeCmd{
+		id:   id,
+		comp: nameTyped[A](),
+	})
+
+	// Any writes added after this must execute after the removal, so stop batching into the previous write
+	delete(c.writes, id)
+}
+
+// Adds a command to store the resource of type A in the world
+func SetResourceCmd[A any](c *Command, val A) {
+	c.list = append(c.list, setResourceCmd[A]{val})
+}
+
+// Adds a command to remove the resource of type A from the world
+func RemoveResourceCmd[A any](c *Command) {
+	c.list = append(c.list, removeResourceCmd[A]{})
+}
+
+type cmd interface {
+	execute(*World)
+}
 
 type writeCmd struct {
 	id    Id
@@ -59,3 +83,26 @@ func newWriteCmd(id Id) *writeCmd {
 func (c *writeCmd) execute(world *World) {
 	world.Write(c.id, c.comps...)
 }
+
+type removeCmd struct {
+	id   Id
+	comp CompId
+}
+
+func (c removeCmd) execute(world *World) {
+	world.removeIds(c.id, c.comp)
+}
+
+type setResourceCmd[A any] struct {
+	val A
+}
+
+func (c setResourceCmd[A]) execute(world *World) {
+	SetResource(world, c.val)
+}
+
+type removeResourceCmd[A any] struct{}
+
+func (c removeResourceCmd[A]) execute(world *World) {
+	RemoveResource[A](world)
+}
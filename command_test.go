@@ -51,3 +51,30 @@ func TestCommandExecution(t *testing.T) {
 	// }
 	// compare(t, count, 1)
 }
+
+func TestCommandRemove(t *testing.T) {
+	world := NewWorld()
+	cmd := NewCommand(world)
+	query := Query2[position, velocity](world, Optional(velocity{}))
+
+	id := world.NewId()
+	pos := position{1, 1, 1}
+	vel := velocity{2, 2, 2}
+	WriteCmd(cmd, id, pos)
+	WriteCmd(cmd, id, vel)
+	RemoveCmd[velocity](cmd, id)
+	cmd.Execute()
+
+	posOut, velOut := query.Read(id)
+	compare(t, *posOut, pos)
+	compare(t, velOut, nil)
+
+	// Writes added after a removal are executed after it
+	RemoveCmd[position](cmd, id)
+	WriteCmd(cmd, id, vel)
+	cmd.Execute()
+
+	posOut, velOut = query.Read(id)
+	compare(t, posOut, nil)
+	compare(t, *velOut, vel)
+}
@@ -0,0 +1,80 @@
+package ecs
+
+// A CompactionPolicy decides when an archetype should have its holes cleaned up. Holes are the rows left behind by deleted or moved entities.
+// The policy is checked whenever an entity gets added to an archetype. Holes are never cleaned up during a Delete, so that deleting stays safe inside of maps and loops
+type CompactionPolicy interface {
+	ShouldCompact(rows, holes int) bool
+}
+
+// Compacts an archetype once it has at least this many holes
+type HoleCountPolicy int
+
+func (p HoleCountPolicy) ShouldCompact(rows, holes int) bool {
+	return holes > 0 && holes >= int(p)
+}
+
+// Compacts an archetype once this fraction (0 to 1) of its rows are holes
+type HolePercentPolicy float64
+
+func (p HolePercentPolicy) ShouldCompact(rows, holes int) bool {
+	if rows <= 0 || holes <= 0 {
+		return false
+	}
+	return float64(holes)/float64(rows) >= float64(p)
+}
+
+// Never compacts automatically. Holes only get cleaned up by calling World.Compact()
+type ManualPolicy struct{}
+
+func (p ManualPolicy) ShouldCompact(rows, holes int) bool {
+	return false
+}
+
+// The policy that worlds start with
+var DefaultCompactionPolicy CompactionPolicy = HoleCountPolicy(1024)
+
+// Sets the policy that decides when archetypes automatically clean up their holes
+func (w *World) SetCompactionPolicy(policy CompactionPolicy) {
+	w.engine.compaction = policy
+}
+
+// Cleans up the holes in every archetype and releases excess capacity from archetypes that have shrunk.
+// This moves entities around inside of their archetypes, so it must not be called inside of maps and loops. A good place to call it is at a frame boundary
+func (w *World) Compact() {
+	w.engine.Compact()
+}
+
+func (e *archEngine) Compact() {
+	for _, arch := range e.dcr.archetypes {
+		if len(arch.lookup.holes) > 0 {
+			e.CleanupHoles(arch.id)
+		}
+
+		arch.lookup.id = shrinkSlice(arch.lookup.id)
+		arch.lookup.holes = shrinkSlice(arch.lookup.holes)
+		for i := range arch.ticks {
+			arch.ticks[i] = shrinkSlice(arch.ticks[i])
+		}
+		for _, col := range arch.columns {
+			if col != nil {
+				col.shrink()
+			}
+		}
+	}
+
+	for _, s := range e.sparse {
+		if s != nil {
+			s.shrink()
+		}
+	}
+}
+
+// Returns a slice with reduced capacity if less than half of the capacity is in use
+func shrinkSlice[T any](s []T) []T {
+	if cap(s) <= 2*len(s) {
+		return s
+	}
+	ret := make([]T, len(s))
+	copy(ret, s)
+	return ret
+}
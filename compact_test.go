@@ -0,0 +1,84 @@
+package ecs
+
+import (
+	"testing"
+)
+
+func TestWorldCompact(t *testing.T) {
+	world := NewWorld()
+	world.SetCompactionPolicy(ManualPolicy{})
+	query := Query2[position, velocity](world)
+
+	ids := make([]Id, 0)
+	for i := 0; i < 10000; i++ {
+		id := world.NewId()
+		v := float64(id)
+		Write(world, id, C(position{v, v, v}), C(velocity{v, v, v}))
+		ids = append(ids, id)
+	}
+
+	// Mass despawn most of the entities
+	for i, id := range ids {
+		if i%10 != 0 {
+			Delete(world, id)
+		}
+	}
+
+	// With the manual policy, new entities don't trigger a cleanup
+	id := world.NewId()
+	Write(world, id, C(position{}), C(velocity{}))
+	Delete(world, id)
+
+	archId := world.engine.locs.get(ids[0]).archId
+	arch := world.engine.getArchetype(archId)
+	compare(t, len(arch.lookup.holes), 9000+1)
+
+	world.Compact()
+	compare(t, len(arch.lookup.holes), 0)
+	compare(t, len(arch.lookup.id), 1000)
+	check(t, cap(arch.lookup.id) < 2*1000)
+	posCol := getColumn[position](arch, name(position{}))
+	check(t, cap(posCol.comp) < 2*1000)
+
+	// Verify the remaining entities are still correct
+	count := 0
+	query.MapId(func(id Id, pos *position, vel *velocity) {
+		expected := float64(id)
+		compare(t, *pos, position{expected, expected, expected})
+		compare(t, *vel, velocity{expected, expected, expected})
+		count++
+	})
+	compare(t, count, 1000)
+
+	for i, id := range ids {
+		pos, _ := query.Read(id)
+		check(t, (pos != nil) == (i%10 == 0))
+	}
+}
+
+func TestCompactionPolicies(t *testing.T) {
+	check(t, !HoleCountPolicy(10).ShouldCompact(100, 9))
+	check(t, HoleCountPolicy(10).ShouldCompact(100, 10))
+	check(t, !HolePercentPolicy(0.5).ShouldCompact(100, 49))
+	check(t, HolePercentPolicy(0.5).ShouldCompact(100, 50))
+	check(t, !HolePercentPolicy(0.5).ShouldCompact(0, 0))
+	check(t, !ManualPolicy{}.ShouldCompact(100, 100))
+
+	world := NewWorld()
+	world.SetCompactionPolicy(HolePercentPolicy(0.5))
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		id := world.NewId()
+		Write(world, id, C(position{}))
+		ids = append(ids, id)
+	}
+	for _, id := range ids[:5] {
+		Delete(world, id)
+	}
+
+	// Adding an entity to the archetype triggers the cleanup
+	Write(world, world.NewId(), C(position{}))
+	arch := world.engine.getArchetype(world.engine.locs.get(ids[5]).archId)
+	compare(t, len(arch.lookup.holes), 0)
+	compare(t, len(arch.lookup.id), 6)
+}
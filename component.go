@@ -2,35 +2,48 @@ package ecs
 
 import (
 	"fmt"
+	"math"
 	"sort"
 )
 
-type componentId uint16
+type CompId uint16
+
+// Returns the CompId of the component value. If the value already is a CompId, then it is returned as is
+func compIdOf(comp any) CompId {
+	if compId, ok := comp.(CompId); ok {
+		return compId
+	}
+	return name(comp)
+}
 
 type Component interface {
 	write(*archEngine, archetypeId, Id)
-	id() componentId
+	writeSparse(*archEngine, Id)
+	id() CompId
 }
 
 // This type is used to box a component with all of its type info so that it implements the component interface. I would like to get rid of this and simplify the APIs
 type Box[T any] struct {
 	Comp   T
-	compId componentId
+	compId CompId
 }
 
 // Createst the boxed component type
 func C[T any](comp T) Box[T] {
 	return Box[T]{
 		Comp:   comp,
-		compId: name(comp),
+		compId: nameTyped[T](),
 	}
 }
 func (c Box[T]) write(engine *archEngine, archId archetypeId, id Id) {
 	writeArch[T](engine, archId, id, c.Comp)
 }
-func (c Box[T]) id() componentId {
+func (c Box[T]) writeSparse(engine *archEngine, id Id) {
+	getSparse[T](engine, c.id()).set(id, c.Comp, engine.tick)
+}
+func (c Box[T]) id() CompId {
 	if c.compId == invalidComponentId {
-		c.compId = name(c.Comp)
+		c.compId = nameTyped[T]()
 	}
 	return c.compId
 }
@@ -42,20 +55,20 @@ func (c Box[T]) Get() T {
 // Dynamic component Registry
 type componentRegistry struct {
 	archCounter archetypeId
-	compCounter componentId
-	archSet     map[componentId]map[archetypeId]bool // Contains the set of archetypeIds that have this component
+	compCounter CompId
+	archetypes  []*archetype // Indexed by archetypeId. Archetypes are never removed, so views only need to check the archetypes that were appended since they last looked
 	trie        *node
-	generation  int
+	tracked     []bool // Indexed by CompId, true if the archetypes keep the change ticks of the component
 }
 
 func newComponentRegistry() *componentRegistry {
 	r := &componentRegistry{
 		archCounter: 0,
 		compCounter: 0,
-		archSet:     make(map[componentId]map[archetypeId]bool),
-		generation:  1, // Start at 1 so that anyone with the default int value will always realize they are in the wrong generation
+		archetypes:  make([]*archetype, 0),
 	}
-	r.trie = newNode(r)
+	r.trie = newNode()
+	r.trie.archId = r.NewarchetypeId(nil) // The root of the trie is the archetype with no components
 	return r
 }
 
@@ -63,84 +76,133 @@ func (r *componentRegistry) print() {
 	fmt.Println("--- componentRegistry ---")
 	fmt.Println("archCounter", r.archCounter)
 	fmt.Println("compCounter", r.compCounter)
-	fmt.Println("-- archSet --")
-	for name, set := range r.archSet {
-		fmt.Printf("name(%d): archId: [ ", name)
-		for archId := range set {
-			fmt.Printf("%d ", archId)
-		}
-		fmt.Printf("]\n")
+	fmt.Println("-- archetypes --")
+	for _, arch := range r.archetypes {
+		fmt.Printf("archId(%d): comps: %v\n", arch.id, arch.comps)
 	}
 }
 
-func (r *componentRegistry) NewarchetypeId() archetypeId {
-	r.generation++ // Increment the generation
+func (r *componentRegistry) NewarchetypeId(comps []CompId) archetypeId {
 	archId := r.archCounter
 	r.archCounter++
+	arch := newArchetype(archId, comps)
+	for i, c := range comps {
+		if r.tracks(c) {
+			arch.ticks[i] = make([]componentTicks, 0)
+		}
+	}
+	r.archetypes = append(r.archetypes, arch)
 	return archId
 }
 
+// Returns true if the archetypes keep the change ticks of the component
+func (r *componentRegistry) tracks(compId CompId) bool {
+	return int(compId) < len(r.tracked) && r.tracked[compId]
+}
+
 // 1. Map all components to their component Id
-// 2. Sort all component ids so that we can index the prefix tree
+// 2. Sort all component ids so that we can index the prefix tree, and remove duplicates
 // 3. Walk the prefix tree to find the archetypeId
 func (r *componentRegistry) GetarchetypeId(comp ...Component) archetypeId {
-	list := make([]componentId, len(comp))
+	list := make([]CompId, len(comp))
 	for i := range comp {
-		list[i] = r.Register(comp[i])
+		list[i] = comp[i].id()
 	}
 	sort.Slice(list, func(i, j int) bool {
 		return list[i] < list[j]
 	})
 
+	// The same component may be written more than once, in which case the last write wins. The archetype must only hold it once
+	unique := list[:0]
+	for i := range list {
+		if i == 0 || list[i] != list[i-1] {
+			unique = append(unique, list[i])
+		}
+	}
+
+	return r.getArchetypeId(unique)
+}
+
+// Walks the prefix tree to find the archetypeId of the sorted list of component ids. The archetype gets created if this is the first time that we've seen the list
+func (r *componentRegistry) getArchetypeId(list []CompId) archetypeId {
 	cur := r.trie
 	for _, idx := range list {
-		cur = cur.Get(r, idx)
+		cur = cur.Get(idx)
 	}
 
-	// Add this archetypeId to every component's archList
-	for _, c := range comp {
-		n := c.id()
-
+	if cur.archId == invalidArchetypeId {
+		cur.archId = r.NewarchetypeId(list)
+	}
+	return cur.archId
+}
 
-		if !r.archSet[n][cur.archId] {
-			r.archSet[n][cur.archId] = true
+// Returns the archetypeId that is reached by adding the component to the archetype
+func (r *componentRegistry) addEdge(archId archetypeId, compId CompId) archetypeId {
+	arch := r.archetypes[archId]
+	next, ok := arch.addEdge[compId]
+	if ok {
+		return next
+	}
 
-			// If this was the first time we've associated this archetype to this component, then we need to bump the generation, so that all views get regenerated based on this update. This could maybe be moved to somewhere else.
-			r.generation++
-		}
+	if arch.has(compId) {
+		next = archId
+	} else {
+		list := make([]CompId, 0, len(arch.comps)+1)
+		list = append(list, arch.comps...)
+		list = append(list, compId)
+		sort.Slice(list, func(i, j int) bool {
+			return list[i] < list[j]
+		})
+		next = r.getArchetypeId(list)
 	}
-	return cur.archId
+
+	arch.addEdge[compId] = next
+	return next
 }
 
-// Registers a component to a component Id and returns the Id
-// If already registered, just return the Id and don't make a new one
-func (r *componentRegistry) Register(comp Component) componentId {
-	compId := comp.id()
+// Returns the archetypeId that is reached by removing the component from the archetype
+func (r *componentRegistry) removeEdge(archId archetypeId, compId CompId) archetypeId {
+	arch := r.archetypes[archId]
+	next, ok := arch.removeEdge[compId]
+	if ok {
+		return next
+	}
 
-	_, ok := r.archSet[compId]
-	if !ok {
-		r.archSet[compId] = make(map[archetypeId]bool)
+	if !arch.has(compId) {
+		next = archId
+	} else {
+		list := make([]CompId, 0, len(arch.comps))
+		for _, c := range arch.comps {
+			if c != compId {
+				list = append(list, c)
+			}
+		}
+		next = r.getArchetypeId(list)
 	}
 
-	return compId
+	arch.removeEdge[compId] = next
+	return next
 }
 
+// Trie nodes that are only on the path to other archetypes don't get an archetype until one is requested
+const invalidArchetypeId = archetypeId(math.MaxUint32)
+
 type node struct {
 	archId archetypeId
 	child  []*node
 }
 
-func newNode(r *componentRegistry) *node {
+func newNode() *node {
 	return &node{
-		archId: r.NewarchetypeId(),
+		archId: invalidArchetypeId,
 		child:  make([]*node, 0),
 	}
 }
 
-func (n *node) Get(r *componentRegistry, id componentId) *node {
-	if id < componentId(len(n.child)) {
+func (n *node) Get(id CompId) *node {
+	if id < CompId(len(n.child)) {
 		if n.child[id] == nil {
-			n.child[id] = newNode(r)
+			n.child[id] = newNode()
 		}
 		return n.child[id]
 	}
@@ -148,7 +210,7 @@ func (n *node) Get(r *componentRegistry, id componentId) *node {
 	// Expand the slice to hold all required children
 	n.child = append(n.child, make([]*node, 1+int(id)-len(n.child))...)
 	if n.child[id] == nil {
-		n.child[id] = newNode(r)
+		n.child[id] = newNode()
 	}
 	return n.child[id]
 }
@@ -0,0 +1,243 @@
+package ecs
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Dynamic components are defined at runtime instead of by a Go type, for example by data files or scripts. They are stored as raw bytes and can live in the same archetypes as typed components
+
+// Maps the name of a dynamic component to its id. This is guarded by componentIdMutex
+var dynamicComponents = make(map[string]CompId)
+
+// Registers a dynamic component with the specified name. Every value of the component is size bytes long and is aligned to align bytes, which must be a power of two.
+// Registering the same name again returns the same CompId. Panics if the name was already registered with a different size or alignment
+func RegisterDynamic(name string, size, align uintptr) CompId {
+	if align == 0 || align&(align-1) != 0 {
+		panic("ecs: Dynamic component alignment must be a power of two")
+	}
+
+	componentIdMutex.Lock()
+	defer componentIdMutex.Unlock()
+
+	compId, ok := dynamicComponents[name]
+	if ok {
+		meta := compMetas[compId]
+		if meta.size != size || meta.align != align {
+			panic(fmt.Sprintf("ecs: Dynamic component %q is already registered with a different size or alignment", name))
+		}
+		return compId
+	}
+
+	newId := componentRegistryCounter
+	compMetas = append(compMetas, compMeta{
+		name:  name,
+		size:  size,
+		align: align,
+	})
+	dynamicComponents[name] = newId
+	componentRegistryCounter++
+	return newId
+}
+
+// A value of a dynamic component, boxed so that it can be written like any other component
+type Dynamic struct {
+	Data   []byte
+	compId CompId
+}
+
+// Boxes the data of the dynamic component, for example: world.Write(id, ecs.Dyn(healthId, data)). The data is copied when it gets written.
+// Panics if the data doesn't have the registered size, so that a bad value is caught before anything gets written
+func Dyn(compId CompId, data []byte) Dynamic {
+	meta, ok := getCompMeta(compId)
+	if !ok || meta.typ != nil {
+		panic(fmt.Sprintf("ecs: Component %d isn't a dynamic component", compId))
+	}
+	if uintptr(len(data)) != meta.size {
+		panic(fmt.Sprintf("ecs: Dynamic component %q has a size of %d bytes, but got %d bytes", meta.name, meta.size, len(data)))
+	}
+	return Dynamic{
+		Data:   data,
+		compId: compId,
+	}
+}
+func (c Dynamic) write(engine *archEngine, archId archetypeId, id Id) {
+	writeDynamic(engine, archId, id, c.compId, c.Data)
+}
+func (c Dynamic) writeSparse(engine *archEngine, id Id) {
+	panic("ecs: Dynamic components can't use sparse storage")
+}
+func (c Dynamic) id() CompId {
+	return c.compId
+}
+
+// Returns the bytes of the entity's dynamic component, or nil if the entity doesn't have the component.
+// The slice points directly into the storage, so writing to it modifies the component. It has the same lifetime caveats as ReadPtr
+func ReadDynamic(world *World, id Id, compId CompId) []byte {
+	col, index := world.engine.componentRow(id, compId)
+	if index < 0 {
+		return nil
+	}
+	dyn, ok := col.(*dynamicColumn)
+	if !ok {
+		return nil
+	}
+	return dyn.row(index)
+}
+
+func writeDynamic(e *archEngine, archId archetypeId, id Id, compId CompId, data []byte) {
+	index := e.allocate(archId, id)
+
+	arch := e.getArchetype(archId)
+	colIdx := arch.columnIndex(compId)
+	if colIdx < 0 {
+		panic(fmt.Sprintf("Archetype %d doesn't contain component: %d", archId, compId))
+	}
+	if arch.columns[colIdx] == nil {
+		arch.columns[colIdx] = newDynamicColumn(compId)
+	}
+	col, ok := arch.columns[colIdx].(*dynamicColumn)
+	if !ok {
+		panic(fmt.Sprintf("ecs: Component %d isn't a dynamic component", compId))
+	}
+	col.write(index, data)
+	arch.stamp(colIdx, index, e.tick)
+}
+
+// A column of raw bytes. Every row is stride bytes apart, so that every row stays aligned
+type dynamicColumn struct {
+	compId CompId
+	size   int
+	align  int
+	stride int    // The size rounded up to the alignment
+	buf    []byte // The allocation. It may start with some padding so that the first row is aligned
+	off    int    // The offset of the first row inside of buf
+	len    int    // The number of rows
+}
+
+func newDynamicColumn(compId CompId) *dynamicColumn {
+	meta, ok := getCompMeta(compId)
+	if !ok || meta.typ != nil {
+		panic(fmt.Sprintf("ecs: Component %d isn't a dynamic component", compId))
+	}
+	size := int(meta.size)
+	align := int(meta.align)
+	return &dynamicColumn{
+		compId: compId,
+		size:   size,
+		align:  align,
+		stride: (size + align - 1) &^ (align - 1),
+	}
+}
+
+// Returns the bytes of the row
+func (c *dynamicColumn) row(index int) []byte {
+	if c.size == 0 {
+		return []byte{} // Not nil, because nil means that the entity doesn't have the component
+	}
+	start := c.off + index*c.stride
+	return c.buf[start : start+c.size : start+c.size]
+}
+
+// Reallocates the column so that it can hold the number of rows
+func (c *dynamicColumn) realloc(rows int) {
+	buf := make([]byte, rows*c.stride+c.align-1)
+	off := 0
+	if len(buf) > 0 {
+		off = int(-uintptr(unsafe.Pointer(&buf[0]))) & (c.align - 1)
+	}
+	copy(buf[off:], c.buf[c.off:c.off+c.len*c.stride])
+	c.buf = buf
+	c.off = off
+}
+
+// Returns the number of rows that fit into the allocation
+func (c *dynamicColumn) capacity() int {
+	if c.stride == 0 {
+		return int(^uint(0) >> 1) // Zero sized rows don't need any memory
+	}
+	return (len(c.buf) - c.off) / c.stride
+}
+
+// Note: This will panic if you write past the buffer by more than 1, just like componentSlice.Write
+func (c *dynamicColumn) write(index int, data []byte) {
+	if len(data) != c.size {
+		panic(fmt.Sprintf("ecs: Dynamic component %d has a size of %d bytes, but got %d bytes", c.compId, c.size, len(data)))
+	}
+	if index == c.len {
+		if c.len == c.capacity() {
+			c.realloc(2*c.len + 1)
+		}
+		c.len++
+	} else if index > c.len {
+		panic("ecs: Dynamic column index out of range")
+	}
+	copy(c.row(index), data)
+}
+
+func (c *dynamicColumn) ReadToEntity(entity *Entity, index int) {
+	entity.Add(c.component(index))
+}
+
+func (c *dynamicColumn) ReadToRawEntity(entity *RawEntity, index int) {
+	entity.comp[c.compId] = c.row(index)
+}
+
+// Deletes the row by moving the last row into it
+func (c *dynamicColumn) Delete(index int) {
+	last := c.row(c.len - 1)
+	copy(c.row(index), last)
+	for i := range last {
+		last[i] = 0
+	}
+	c.len--
+}
+
+func (c *dynamicColumn) moveRow(src column, srcIndex int, dstIndex int) {
+	c.write(dstIndex, src.(*dynamicColumn).row(srcIndex))
+}
+
+// The bytes are opaque, so there is no way to deep copy them
+func (c *dynamicColumn) cloneRow(src column, srcIndex int, dstIndex int) {
+	c.moveRow(src, srcIndex, dstIndex)
+}
+
+func (c *dynamicColumn) remapRow(index int, remap func(Id) Id) {}
+
+// Dynamic components don't have a Go type, so the pointer is the slice of their bytes
+func (c *dynamicColumn) pointer(index int) any {
+	return c.row(index)
+}
+
+func (c *dynamicColumn) component(index int) Component {
+	data := make([]byte, c.size)
+	copy(data, c.row(index))
+	return Dyn(c.compId, data)
+}
+
+func (c *dynamicColumn) newColumn() column {
+	return &dynamicColumn{
+		compId: c.compId,
+		size:   c.size,
+		align:  c.align,
+		stride: c.stride,
+	}
+}
+
+func (c *dynamicColumn) shrink() {
+	if c.stride > 0 && c.capacity() > 2*c.len {
+		c.realloc(c.len)
+	}
+}
+
+func (c *dynamicColumn) clear() {
+	used := c.buf[c.off : c.off+c.len*c.stride]
+	for i := range used {
+		used[i] = 0
+	}
+	c.len = 0
+}
+
+func (c *dynamicColumn) capacityBytes() int {
+	return len(c.buf)
+}
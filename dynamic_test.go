@@ -0,0 +1,115 @@
+package ecs
+
+import (
+	"encoding/binary"
+	"testing"
+	"unsafe"
+)
+
+func TestDynamicComponents(t *testing.T) {
+	world := NewWorld()
+	health := RegisterDynamic("test.health", 4, 4)
+	compare(t, RegisterDynamic("test.health", 4, 4), health)
+	mana := RegisterDynamic("test.mana", 2, 16)
+	marker := RegisterDynamic("test.marker", 0, 1)
+
+	data := func(v uint32) []byte {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, v)
+		return b
+	}
+
+	ids := make([]Id, 0)
+	for i := 0; i < 100; i++ {
+		id := world.NewId()
+		Write(world, id, C(position{float64(i), 0, 0}), Dyn(health, data(uint32(i))))
+		if i%2 == 0 {
+			Write(world, id, Dyn(mana, []byte{byte(i), 1}), Dyn(marker, nil))
+		}
+		ids = append(ids, id)
+	}
+
+	for i, id := range ids {
+		compare(t, binary.LittleEndian.Uint32(ReadDynamic(world, id, health)), uint32(i))
+		pos, ok := Read[position](world, id)
+		check(t, ok)
+		compare(t, pos.x, float64(i))
+
+		m := ReadDynamic(world, id, mana)
+		if i%2 == 0 {
+			compare(t, len(m), 2)
+			compare(t, m[0], byte(i))
+			compare(t, uintptr(unsafe.Pointer(&m[0]))%16, 0) // Every row is aligned
+			check(t, ReadDynamic(world, id, marker) != nil)
+		} else {
+			check(t, m == nil)
+		}
+	}
+
+	// The returned slice points into the storage
+	binary.LittleEndian.PutUint32(ReadDynamic(world, ids[3], health), 300)
+	compare(t, binary.LittleEndian.Uint32(ReadDynamic(world, ids[3], health)), 300)
+
+	// Dynamic components can be filtered by their id
+	count := 0
+	Query1[position](world, With(mana)).MapId(func(id Id, p *position) {
+		count++
+	})
+	compare(t, count, 50)
+
+	// And removed by their id
+	check(t, world.Remove(ids[0], mana))
+	check(t, ReadDynamic(world, ids[0], mana) == nil)
+	compare(t, binary.LittleEndian.Uint32(ReadDynamic(world, ids[0], health)), 0)
+
+	// Deleting entities moves rows inside of the raw columns
+	for i := 0; i < 50; i++ {
+		Delete(world, ids[i])
+	}
+	world.Compact()
+	for i := 50; i < 100; i++ {
+		compare(t, binary.LittleEndian.Uint32(ReadDynamic(world, ids[i], health)), uint32(i))
+	}
+
+	// Dynamic components show up in entities and stats
+	ent := ReadEntity(world, ids[50])
+	compare(t, len(ent.comp), 4)
+	compare(t, ent.comp[mana].(Dynamic).Data[0], byte(50))
+
+	clone := Clone(world, ids[52])
+	compare(t, binary.LittleEndian.Uint32(ReadDynamic(world, clone, health)), 52)
+	found := false
+	for _, arch := range world.Stats().Archetypes {
+		for _, name := range arch.Components {
+			found = found || name == "test.mana"
+		}
+	}
+	check(t, found)
+}
+
+func TestDynamicRegisterMismatch(t *testing.T) {
+	RegisterDynamic("test.mismatch", 4, 4)
+	defer func() {
+		check(t, recover() != nil)
+	}()
+	RegisterDynamic("test.mismatch", 8, 4)
+}
+
+func TestDynamicWrongSize(t *testing.T) {
+	world := NewWorld()
+	health := RegisterDynamic("test.health", 4, 4)
+
+	id := world.NewId()
+	func() {
+		defer func() {
+			check(t, recover() != nil)
+		}()
+		world.Write(id, C(position{}), Dyn(health, []byte{1}))
+	}()
+	check(t, !world.Exists(id))
+
+	// The archetype wasn't left with a row that is missing its dynamic component
+	id = world.NewId()
+	world.Write(id, C(position{}), Dyn(health, []byte{1, 2, 3, 4}))
+	compare(t, ReadDynamic(world, id, health)[3], byte(4))
+}
@@ -3,12 +3,12 @@ package ecs
 // An Entity is essentially a map of components that is held external to a world. Useful for pulling full entities in and out of the world.
 // Deprecated: This type and its corresponding methods are tentative and might be replaced by something else.
 type Entity struct {
-	comp map[componentId]Component
+	comp map[CompId]Component
 }
 
 // Creates a new entity with the specified components
 func NewEntity(components ...Component) *Entity {
-	c := make(map[componentId]Component)
+	c := make(map[CompId]Component)
 	for i := range components {
 		c[components[i].id()] = components[i]
 	}
@@ -43,7 +43,7 @@ func (e *Entity) Comps() []Component {
 // Reads a specific component from the entity, returns false if the component doesn't exist
 func ReadFromEntity[T any](ent *Entity) (T, bool) {
 	var t T
-	n := name(t)
+	n := nameTyped[T]()
 
 	icomp, ok := ent.comp[n]
 	if !ok {
@@ -60,12 +60,12 @@ func (ent *Entity) Write(world *World, id Id) {
 
 // Reads the entire entity out of the world and into an *Entity object. Returns nil if the entity doesn't exist
 func ReadEntity(world *World, id Id) *Entity {
-	archId, ok := world.arch[id]
-	if !ok {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
 		return nil
 	}
 
-	return world.engine.ReadEntity(archId, id)
+	return world.engine.ReadEntity(loc.archId, id)
 }
 
 // Deletes a component on this entity
@@ -81,37 +81,15 @@ func (e *Entity) Clear() {
 	}
 }
 
-// TODO revisit this abstraction
-// type Copier interface {
-// 	Copy() interface{}
-// }
-
-// func (e Entity) Copy() Entity {
-// 	copy := BlankEntity()
-// 	for k,v := range e {
-// 		c, ok := v.(Copier)
-// 		if ok {
-// 			// fmt.Println("Copying:", k)
-// 			// If the component has a custom copy interface, then copy it
-// 			copy[k] = c.Copy()
-// 		} else {
-// 			// Else just copy the top level struct
-// 			copy[k] = v
-// 		}
-// 	}
-// 	return copy
-// }
-
-
 // A RawEntity is like an Entity, but every component is actually a pointer to the underlying component. I mostly use this to build inspector UIs that can directly modify an entity
 // Deprecated: This type and its corresponding methods are tentative and might be replaced by something else.
 type RawEntity struct {
-	comp map[componentId]any
+	comp map[CompId]any
 }
 
 // Creates a new entity with the specified components
 func NewRawEntity(components ...any) *RawEntity {
-	c := make(map[componentId]any)
+	c := make(map[CompId]any)
 	for i := range components {
 		c[name(components[i])] = components[i]
 	}
@@ -163,12 +141,12 @@ func (e *RawEntity) Comps() []any {
 
 // Reads the entire entity out of the world and into an *RawEntity object. Returns nil if the entity doesn't exist. RawEntity is lik
 func ReadRawEntity(world *World, id Id) *RawEntity {
-	archId, ok := world.arch[id]
-	if !ok {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
 		return nil
 	}
 
-	return world.engine.ReadRawEntity(archId, id)
+	return world.engine.ReadRawEntity(loc.archId, id)
 }
 
 // Deletes a component on this entity
@@ -1,80 +1,80 @@
 package ecs
 
-// import "fmt"
-
-// type without struct {
-// 	fields []any
-// }
-// func Without(fields ...any) without {
-// 	return without{fields}
-// }
-// extractedFilters := make([]any, 0)
-// for _, f := range filters {
-// 	switch t := f.(type) {
-// 	case without:
-// 	}
-// }
-
 // type buildQuery interface {
 // 	build(world)
 // }
 
-// TODO - Filter types:
+// Filters narrow down which entities a view iterates. They can be combined into nested expressions with AllOf, AnyOf and None:
 // Optional - Lets you view even if component is missing (func will return nil)
 // With - Lets you add additional components that must be present
 // Without - Lets you add additional components that must not be present
+// AllOf, AnyOf, None - Require that all, any or none of the filters inside of them match
+// Added, Changed - Require that a component was added or changed since the last time that the view ran
 type Filter interface {
-	Filter([]componentId) []componentId
+	// Modifies the list of components that the view requires
+	Filter([]CompId) []CompId
+
+	// Returns the expression that entities must match, or nil if the filter doesn't restrict which entities match
+	expr() *filterNode
 }
 
-// type without struct {
-// 	comps []any
-// }
+type filterOp uint8
+
+const (
+	opAll filterOp = iota
+	opAny
+	opNone
+	opAdded   // Matches if the component was added since the view last ran
+	opChanged // Matches if the component was added or changed since the view last ran
+)
+
+// A node in a filter expression. A node matches if all, any or none of its components and children are present. Added and Changed nodes hold a single component
+type filterNode struct {
+	op       filterOp
+	comps    []CompId
+	children []*filterNode
+}
 
-// TODO - figure out how to implement
-// // Creates a filter to ensure that entities will not have the specified components
-// func Without(comps ...any) without {
-// 	return without{
-// 		comps: comps,
-// 	}
-// }
+func compIds(comps []any) []CompId {
+	ids := make([]CompId, len(comps))
+	for i := range comps {
+		ids[i] = compIdOf(comps[i])
+	}
+	return ids
+}
 
 type with struct {
-	comps []componentId
+	comps []CompId
 }
 
-// Creates a filter to ensure that entities have the specified components
+// Creates a filter to ensure that entities have the specified components. Components are specified by a value of their type, or by their CompId
 func With(comps ...any) with {
-	ids := make([]componentId, len(comps))
-	for i := range comps {
-		ids[i] = name(comps[i])
-	}
 	return with{
-		comps: ids,
+		comps: compIds(comps),
 	}
 }
 
-func (w with) Filter(list []componentId) []componentId {
-	return append(list, w.comps...)
+func (w with) Filter(list []CompId) []CompId {
+	return list
+}
+
+func (w with) expr() *filterNode {
+	return &filterNode{op: opAll, comps: w.comps}
 }
 
 type optional struct {
-	comps []componentId
+	comps []CompId
 }
 
 // Creates a filter to make the query still iterate even if a specific component is missing, in which case you'll get nil if the component isn't there when accessed
+// Components are specified by a value of their type, or by their CompId
 func Optional(comps ...any) optional {
-	ids := make([]componentId, len(comps))
-	for i := range comps {
-		ids[i] = name(comps[i])
-	}
-
 	return optional{
-		comps: ids,
+		comps: compIds(comps),
 	}
 }
 
-func (f optional) Filter(list []componentId) []componentId {
+func (f optional) Filter(list []CompId) []CompId {
 	for i := 0; i < len(list); i++ {
 		for j := range f.comps {
 			if list[i] == f.comps[j] {
@@ -91,27 +91,370 @@ func (f optional) Filter(list []componentId) []componentId {
 	return list
 }
 
+// Optional only changes which of the view's own components are required, so inside of a group it always matches
+func (f optional) expr() *filterNode {
+	return nil
+}
+
+type without struct {
+	comps []CompId
+}
+
+// Creates a filter to ensure that entities don't have any of the specified components. Components are specified by a value of their type, or by their CompId
+func Without(comps ...any) without {
+	return without{
+		comps: compIds(comps),
+	}
+}
+
+func (f without) Filter(list []CompId) []CompId {
+	return list
+}
+
+func (f without) expr() *filterNode {
+	return &filterNode{op: opNone, comps: f.comps}
+}
+
+type filterGroup struct {
+	op      filterOp
+	filters []Filter
+}
+
+// Creates a filter that matches if all of the filters match
+func AllOf(filters ...Filter) filterGroup {
+	return filterGroup{op: opAll, filters: filters}
+}
+
+// Creates a filter that matches if at least one of the filters matches. For example, AnyOf(With(Sprite{}), With(Mesh{}))
+func AnyOf(filters ...Filter) filterGroup {
+	return filterGroup{op: opAny, filters: filters}
+}
+
+// Creates a filter that matches if none of the filters match
+func None(filters ...Filter) filterGroup {
+	return filterGroup{op: opNone, filters: filters}
+}
+
+// Groups don't change the list of required components, only the filters at the top level of a view do
+func (g filterGroup) Filter(list []CompId) []CompId {
+	return list
+}
+
+func (g filterGroup) expr() *filterNode {
+	node := &filterNode{op: g.op}
+	for _, f := range g.filters {
+		child := f.expr()
+		if child == nil {
+			child = &filterNode{op: opAll} // Matches everything
+		}
+		node.children = append(node.children, child)
+	}
+	return node
+}
+
+type changeFilter struct {
+	op   filterOp
+	comp CompId
+}
+
+// Creates a filter that only matches entities whose component of type T was added since the last time that the view ran.
+// The first run of a view matches every entity that has the component
+func Added[T any]() changeFilter {
+	return changeFilter{op: opAdded, comp: nameTyped[T]()}
+}
+
+// Creates a filter that only matches entities whose component of type T was added or changed since the last time that the view ran.
+// A component is changed when it gets written, or when a view hands out a pointer to it in MapId or MapSlices. A view doesn't see the changes it made itself.
+// The first run of a view matches every entity that has the component
+func Changed[T any]() changeFilter {
+	return changeFilter{op: opChanged, comp: nameTyped[T]()}
+}
+
+func (f changeFilter) Filter(list []CompId) []CompId {
+	return list
+}
+
+func (f changeFilter) expr() *filterNode {
+	return &filterNode{op: f.op, comps: []CompId{f.comp}}
+}
+
+// The result of matching a filter expression against an archetype
+type matchResult int8
+
+const (
+	matchNo matchResult = iota
+	matchYes
+	matchMaybe // The result depends on sparse components or change ticks, so every entity must be checked
+)
+
+// A filter expression that is compiled for a world. The components are split into the ones stored in archetypes, which can be checked once per archetype, and the sparse ones, which must be checked for every entity
+type filterExpr struct {
+	op       filterOp
+	mask     bitset
+	sparse   []CompId
+	comp     CompId // The component of Added and Changed expressions
+	children []*filterExpr
+}
+
+func compileFilter(world *World, node *filterNode) (*filterExpr, bool) {
+	e := &filterExpr{
+		op:     node.op,
+		mask:   newBitset(),
+		sparse: make([]CompId, 0),
+	}
+	for _, c := range node.comps {
+		if world.engine.getSparseStorage(c) != nil {
+			e.sparse = append(e.sparse, c)
+		} else {
+			e.mask.set(c)
+		}
+	}
+
+	// Change ticks are different for every entity, so they always have to be checked per entity
+	if node.op == opAdded || node.op == opChanged {
+		e.comp = node.comps[0]
+		world.engine.track(e.comp) // Only the components that some view filters on pay for keeping their ticks
+		return e, true
+	}
+
+	hasSparse := len(e.sparse) > 0
+	for _, child := range node.children {
+		childExpr, childSparse := compileFilter(world, child)
+		e.children = append(e.children, childExpr)
+		hasSparse = hasSparse || childSparse
+	}
+	return e, hasSparse
+}
+
+// Returns the sparse components that every entity which matches the expression must have
+func (e *filterExpr) requiredSparse() []CompId {
+	if e.op != opAll {
+		return nil
+	}
+	ret := append([]CompId(nil), e.sparse...)
+	for _, child := range e.children {
+		ret = append(ret, child.requiredSparse()...)
+	}
+	return ret
+}
+
+// Matches the expression against an archetype
+func (e *filterExpr) matchArch(mask bitset) matchResult {
+	switch e.op {
+	case opAll:
+		if !mask.containsAll(e.mask) {
+			return matchNo
+		}
+		result := matchYes
+		if len(e.sparse) > 0 {
+			result = matchMaybe
+		}
+		for _, child := range e.children {
+			switch child.matchArch(mask) {
+			case matchNo:
+				return matchNo
+			case matchMaybe:
+				result = matchMaybe
+			}
+		}
+		return result
+	case opAny:
+		return e.matchAnyArch(mask)
+	case opNone:
+		switch e.matchAnyArch(mask) {
+		case matchYes:
+			return matchNo
+		case matchNo:
+			return matchYes
+		}
+		return matchMaybe
+	case opAdded, opChanged:
+		if !mask.containsAll(e.mask) {
+			return matchNo
+		}
+		return matchMaybe
+	}
+	panic("Bug: Unknown filter op")
+}
+
+func (e *filterExpr) matchAnyArch(mask bitset) matchResult {
+	if mask.intersects(e.mask) {
+		return matchYes
+	}
+	result := matchNo
+	if len(e.sparse) > 0 {
+		result = matchMaybe
+	}
+	for _, child := range e.children {
+		switch child.matchArch(mask) {
+		case matchYes:
+			return matchYes
+		case matchMaybe:
+			result = matchMaybe
+		}
+	}
+	return result
+}
+
+// Matches the expression against the entity at the index of the archetype. Added and Changed compare the change ticks against the last run
+func (e *filterExpr) matchRow(world *World, arch *archetype, index int, lastRun uint64) bool {
+	switch e.op {
+	case opAll:
+		if !arch.mask.containsAll(e.mask) {
+			return false
+		}
+		id := arch.lookup.id[index]
+		for _, c := range e.sparse {
+			if !world.engine.sparse[c].has(id) {
+				return false
+			}
+		}
+		for _, child := range e.children {
+			if !child.matchRow(world, arch, index, lastRun) {
+				return false
+			}
+		}
+		return true
+	case opAny:
+		return e.matchAnyRow(world, arch, index, lastRun)
+	case opNone:
+		return !e.matchAnyRow(world, arch, index, lastRun)
+	case opAdded:
+		t := e.rowTicks(world, arch, index)
+		return t != nil && tickAfter(t.added, lastRun)
+	case opChanged:
+		t := e.rowTicks(world, arch, index)
+		return t != nil && tickAfter(t.changed, lastRun)
+	}
+	panic("Bug: Unknown filter op")
+}
+
+func (e *filterExpr) matchAnyRow(world *World, arch *archetype, index int, lastRun uint64) bool {
+	if arch.mask.intersects(e.mask) {
+		return true
+	}
+	id := arch.lookup.id[index]
+	for _, c := range e.sparse {
+		if world.engine.sparse[c].has(id) {
+			return true
+		}
+	}
+	for _, child := range e.children {
+		if child.matchRow(world, arch, index, lastRun) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the change ticks of the component of an Added or Changed expression, or nil if the entity doesn't have the component
+func (e *filterExpr) rowTicks(world *World, arch *archetype, index int) *componentTicks {
+	if len(e.sparse) > 0 {
+		return world.engine.sparse[e.comp].ticks(arch.lookup.id[index])
+	}
+	ticks := arch.columnTicks(e.comp)
+	if ticks == nil {
+		return nil
+	}
+	return &ticks[index]
+}
+
 type filterList struct {
-	comps                     []componentId
-	cachedArchetypeGeneration int // Denotes the world's archetype generation that was used to create the list of archIds. If the world has a new generation, we should probably regenerate
-	archIds                   []archetypeId
+	comps     []CompId
+	expr      *filterExpr // Every view requires all of its components plus all of its filters
+	rowFilter bool        // True if the filter must check every entity, instead of only every archetype
+	lastRun   uint64      // The tick of the last run of the view, which Added and Changed compare against. 0 if the view never ran, so its first run sees every component as added
+	checked   int         // The number of archetypes that have been checked against the expression. Archetypes are only ever appended, so we only need to check the newer ones
+	archIds   []archetypeId
+	archMatch []bool   // Indexed by archetypeId, true if the archetype is in archIds
+	sparse    []CompId // The sparse components that every matching entity must have
+	sparseIds []Id     // Reused by takeSparseIds, so that iterating doesn't allocate
 }
 
-func newFilterList(comps []componentId, filters ...Filter) filterList {
+func newFilterList(world *World, comps []CompId, filters ...Filter) filterList {
+	root := &filterNode{op: opAll}
 	for _, f := range filters {
 		comps = f.Filter(comps)
+		if child := f.expr(); child != nil {
+			root.children = append(root.children, child)
+		}
 	}
+	root.comps = comps
+
+	expr, rowFilter := compileFilter(world, root)
+
+	world.views++
 
 	return filterList{
-		comps:   comps,
-		archIds: make([]archetypeId, 0),
+		comps:     comps,
+		expr:      expr,
+		rowFilter: rowFilter,
+		archIds:   make([]archetypeId, 0),
+		archMatch: make([]bool, 0),
+		sparse:    expr.requiredSparse(),
 	}
 }
+
+// Returns true if the entity at the index of the archetype matches the filter. Only needs to be called if rowFilter is true, otherwise every entity in the matched archetypes matches
+func (f *filterList) matchRow(world *World, arch *archetype, index int) bool {
+	return f.expr.matchRow(world, arch, index, f.lastRun)
+}
+
+// Starts a run of the view and returns its tick. Every component that the view hands out gets stamped with it
+func (f *filterList) beginRun(world *World) uint64 {
+	return world.engine.nextTick()
+}
+
+// Ends the run of the view. Added and Changed only match components that were stamped after the tick of this run, so the view doesn't see its own changes
+func (f *filterList) endRun(world *World, tick uint64) {
+	f.lastRun = tick
+	world.engine.nextTick()
+}
+
+// Adds any archetypes that were created since the last call and match the filter
 func (f *filterList) regenerate(world *World) {
-	if world.engine.generation() != f.cachedArchetypeGeneration {
-		f.archIds = world.engine.FilterList(f.archIds, f.comps)
-		f.cachedArchetypeGeneration = world.engine.generation()
+	archetypes := world.engine.dcr.archetypes
+	for ; f.checked < len(archetypes); f.checked++ {
+		arch := archetypes[f.checked]
+		match := f.expr.matchArch(arch.mask) != matchNo
+		if match {
+			f.archIds = append(f.archIds, arch.id)
+		}
+		f.archMatch = append(f.archMatch, match)
+	}
+}
+
+// Returns true if the view requires a sparse component. Only the entities in that component's set can match, so the view iterates the set instead of every archetype
+func (f *filterList) sparseDriven() bool {
+	return len(f.sparse) > 0
+}
+
+// Returns a copy of the ids in the smallest sparse set that the view requires. The copy stays valid if the sparse sets change during the iteration, and must be given back with releaseSparseIds
+func (f *filterList) takeSparseIds(world *World) []Id {
+	var smallest sparseStorage
+	for _, c := range f.sparse {
+		s := world.engine.sparse[c]
+		if smallest == nil || s.len() < smallest.len() {
+			smallest = s
+		}
+	}
+
+	ids := append(f.sparseIds[:0], smallest.entities()...)
+	f.sparseIds = nil // A nested run of the same view gets its own copy
+	return ids
+}
+
+func (f *filterList) releaseSparseIds(ids []Id) {
+	f.sparseIds = ids
+}
+
+// Returns the archetype and the row of the entity, or nil if the entity doesn't exist or its archetype doesn't match the filter
+func (f *filterList) locate(world *World, id Id) (*archetype, int) {
+	loc := world.engine.locs.get(id)
+	if loc == nil || int(loc.archId) >= len(f.archMatch) || !f.archMatch[loc.archId] {
+		return nil, -1
 	}
+	return world.engine.getArchetype(loc.archId), loc.index
 }
 
 /* Note: replaced all this with code generation
@@ -128,7 +471,7 @@ func Query1[A any](world *World, filters ...Filter) *View1[A] {
 	storageA := getStorage[A](world.engine)
 
 	var a A
-	comps := []componentId{
+	comps := []CompId{
 		name(a),
 	}
 	filterList := newFilterList(comps, filters...)
@@ -204,7 +547,7 @@ func Query2[A,B any](world *World, filters ...Filter) *View2[A,B] {
 
 	var a A
 	var b B
-	comps := []componentId{
+	comps := []CompId{
 		name(a),
 		name(b),
 	}
@@ -394,7 +737,7 @@ func Query3[A,B,C any](world *World, filters ...Filter) *View3[A,B,C] {
 	var b B
 	var c C
 
-	comps := []componentId{
+	comps := []CompId{
 		name(a),
 		name(b),
 		name(c),
@@ -531,7 +874,7 @@ func Query4[A,B,C,D any](world *World, filters ...Filter) *View4[A,B,C,D] {
 	var b B
 	var c C
 	var d D
-	comps := []componentId{
+	comps := []CompId{
 		name(a),
 		name(b),
 		name(c),
@@ -651,7 +994,7 @@ func Query5[A,B,C,D,E any](world *World, filters ...Filter) *View5[A,B,C,D,E] {
 	var c C
 	var d D
 	var e E
-	comps := []componentId{
+	comps := []CompId{
 		name(a),
 		name(b),
 		name(c),
@@ -784,7 +1127,7 @@ func Query6[A,B,C,D,E,F any](world *World, filters ...Filter) *View6[A,B,C,D,E,F
 	var d D
 	var e E
 	var f F
-	comps := []componentId{
+	comps := []CompId{
 		name(a),
 		name(b),
 		name(c),
@@ -0,0 +1,291 @@
+package ecs
+
+import (
+	"math"
+	"testing"
+)
+
+type frozen struct{}
+
+func TestWithoutFilter(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		id := world.NewId()
+		Write(world, id, C(position{}), C(velocity{1, 1, 1}))
+		if i%2 == 0 {
+			Write(world, id, C(frozen{}))
+		}
+		if i%3 == 0 {
+			Write(world, id, C(selected{}))
+		}
+		ids = append(ids, id)
+	}
+
+	query := Query2[position, velocity](world, Without(frozen{}))
+	query.MapId(func(id Id, p *position, v *velocity) {
+		p.x += v.x
+	})
+	for i, id := range ids {
+		pos, _ := Read[position](world, id)
+		if i%2 == 0 {
+			compare(t, pos.x, 0.0)
+		} else {
+			compare(t, pos.x, 1.0)
+		}
+	}
+
+	count := 0
+	query.MapSlices(func(id []Id, p []position, v []velocity) {
+		for i := range id {
+			if id[i] != InvalidEntity {
+				count++
+			}
+		}
+	})
+	compare(t, count, 5)
+
+	// Read still returns entities that don't match the filter
+	p, _ := query.Read(ids[0])
+	check(t, p != nil)
+
+	// Newly created archetypes are excluded too
+	Write(world, world.NewId(), C(position{}), C(velocity{}), C(frozen{}), C(radius{}))
+	Write(world, world.NewId(), C(position{}), C(velocity{}), C(radius{}))
+	count = 0
+	query.MapId(func(id Id, p *position, v *velocity) {
+		count++
+	})
+	compare(t, count, 6)
+
+	// Sparse components can be excluded
+	count = 0
+	Query1[position](world, Without(frozen{}, selected{})).MapId(func(id Id, p *position) {
+		count++
+	})
+	compare(t, count, 4) // Ids 1, 5, 7 and the new one with radius
+}
+
+func TestFilterExpressions(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	// Bit 0: velocity, bit 1: radius, bit 2: frozen, bit 3: selected
+	ids := make([]Id, 16)
+	for i := range ids {
+		id := world.NewId()
+		Write(world, id, C(position{x: float64(i)}))
+		if i&1 != 0 {
+			Write(world, id, C(velocity{}))
+		}
+		if i&2 != 0 {
+			Write(world, id, C(radius{}))
+		}
+		if i&4 != 0 {
+			Write(world, id, C(frozen{}))
+		}
+		if i&8 != 0 {
+			Write(world, id, C(selected{}))
+		}
+		ids[i] = id
+	}
+
+	// Checks that the query matches exactly the entities that the predicate returns true for
+	expect := func(pred func(i int) bool, filters ...Filter) {
+		t.Helper()
+		matched := make([]bool, len(ids))
+		Query1[position](world, filters...).MapId(func(id Id, p *position) {
+			matched[int(p.x)] = true
+		})
+		for i := range ids {
+			compare(t, matched[i], pred(i))
+		}
+	}
+
+	expect(func(i int) bool { return i&1 != 0 || i&2 != 0 },
+		AnyOf(With(velocity{}), With(radius{})))
+
+	expect(func(i int) bool { return i&1 != 0 && i&2 != 0 },
+		AllOf(With(velocity{}), With(radius{})))
+
+	expect(func(i int) bool { return i&1 == 0 && i&4 == 0 },
+		None(With(velocity{}), With(frozen{})))
+
+	// Nested groups
+	expect(func(i int) bool { return i&1 != 0 || (i&2 == 0 && i&4 == 0) },
+		AnyOf(With(velocity{}), None(With(radius{}), With(frozen{}))))
+
+	// Groups combine with the other top level filters
+	expect(func(i int) bool { return i&4 == 0 && (i&1 != 0 || i&2 != 0) },
+		Without(frozen{}), AnyOf(With(velocity{}), With(radius{})))
+
+	// Sparse components are checked per entity
+	expect(func(i int) bool { return i&4 != 0 || i&8 != 0 },
+		AnyOf(With(frozen{}), With(selected{})))
+	expect(func(i int) bool { return !(i&1 != 0 && i&8 != 0) },
+		None(AllOf(With(velocity{}), With(selected{}))))
+
+	// Optional inside of a group always matches
+	expect(func(i int) bool { return true },
+		AnyOf(Optional(velocity{}), With(radius{})))
+}
+
+func TestChangeFilters(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	ids := make([]Id, 5)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{}), C(radius{}), C(selected{}))
+	}
+
+	// Runs the view and returns the ids that it matched
+	// Note: The views iterate radius, because every view marks the components that it hands out as changed
+	run := func(query *View1[radius]) []Id {
+		ret := make([]Id, 0)
+		query.MapId(func(id Id, r *radius) {
+			ret = append(ret, id)
+		})
+		return ret
+	}
+
+	added := Query1[radius](world, Added[position]())
+	changed := Query1[radius](world, Changed[position]())
+	sparseChanged := Query1[radius](world, Changed[selected]())
+
+	// The first run sees every entity
+	compare(t, len(run(added)), 5)
+	compare(t, len(run(added)), 0)
+	compare(t, len(run(changed)), 5)
+	compare(t, len(run(changed)), 0)
+	compare(t, len(run(sparseChanged)), 5)
+	compare(t, len(run(sparseChanged)), 0)
+
+	// Writing changes the component, but doesn't add it again
+	Write(world, ids[1], C(position{1, 1, 1}))
+	compare(t, len(run(added)), 0)
+	match := run(changed)
+	compare(t, len(match), 1)
+	compare(t, match[0], ids[1])
+
+	// Moving an entity to a new archetype keeps its ticks
+	Write(world, ids[2], C(velocity{}))
+	compare(t, len(run(added)), 0)
+	compare(t, len(run(changed)), 0)
+
+	// Adding the component to an existing entity
+	id := world.NewId()
+	Write(world, id, C(radius{}))
+	Write(world, id, C(position{}))
+	match = run(added)
+	compare(t, len(match), 1)
+	compare(t, match[0], id)
+	compare(t, len(run(changed)), 1)
+
+	// Views mark the components that they hand out as changed, but don't see their own changes
+	query := Query1[position](world, Changed[position]())
+	query.MapId(func(id Id, p *position) {})
+	compare(t, len(run(changed)), 6)
+	compare(t, len(run(added)), 0)
+	count := 0
+	query.MapId(func(id Id, p *position) { count++ })
+	compare(t, count, 0)
+
+	// Sparse components are tracked too
+	Write(world, ids[3], C(selected{}))
+	match = run(sparseChanged)
+	compare(t, len(match), 1)
+	compare(t, match[0], ids[3])
+
+	// Spawned and cloned entities are added
+	SpawnBatch2[position, radius](world, 3, nil)
+	Clone(world, ids[0])
+	compare(t, len(run(added)), 4)
+
+	// Change filters compose with the other filters
+	group := Query1[radius](world, AnyOf(Changed[position](), Changed[selected]()), Without(velocity{}))
+	run(group)
+	Write(world, ids[4], C(selected{}))
+	Write(world, ids[3], C(position{}))
+	Write(world, ids[2], C(position{}), C(selected{})) // Has velocity
+	match = run(group)
+	compare(t, len(match), 2)
+	check(t, (match[0] == ids[3] && match[1] == ids[4]) || (match[0] == ids[4] && match[1] == ids[3]))
+}
+
+func TestChangeFiltersTickWrap(t *testing.T) {
+	world := NewWorld()
+	world.engine.tick = math.MaxUint32 - 2 // The components get stamped right before 32 bit ticks would wrap around
+
+	ids := make([]Id, 3)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{}), C(radius{}))
+	}
+
+	count := func(query *View1[radius]) int {
+		n := 0
+		query.MapId(func(id Id, r *radius) { n++ })
+		return n
+	}
+	changed := Query1[radius](world, Changed[position]())
+	added := Query1[radius](world, Added[position]())
+	compare(t, count(changed), 3)
+	compare(t, count(added), 3)
+
+	// Components that haven't changed for a very long time don't match again
+	world.engine.tick += math.MaxInt32 + 10
+	compare(t, count(changed), 0)
+	compare(t, count(added), 0)
+	world.engine.tick += math.MaxUint32
+	compare(t, count(changed), 0)
+
+	Write(world, ids[1], C(position{1, 1, 1}))
+	compare(t, count(changed), 1)
+	compare(t, count(added), 0)
+}
+
+func TestChangeTrackingOptIn(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	ids := make([]Id, 3)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{}), C(radius{}), C(selected{}))
+	}
+
+	// Components only keep ticks once a view filters on them
+	arch := world.engine.getArchetype(world.engine.locs.get(ids[0]).archId)
+	check(t, arch.columnTicks(nameTyped[position]()) == nil)
+	check(t, world.engine.sparse[nameTyped[selected]()].ticks(ids[0]) == nil)
+	Query2[position, radius](world).MapId(func(id Id, p *position, r *radius) {})
+	check(t, arch.columnTicks(nameTyped[position]()) == nil)
+
+	// The components that existed before count as added
+	changed := Query1[radius](world, Changed[position]())
+	sparseAdded := Query1[radius](world, Added[selected]())
+	compare(t, len(arch.columnTicks(nameTyped[position]())), 3)
+	check(t, arch.columnTicks(nameTyped[radius]()) == nil)
+	count := func(query *View1[radius]) int {
+		n := 0
+		query.MapId(func(id Id, r *radius) { n++ })
+		return n
+	}
+	compare(t, count(changed), 3)
+	compare(t, count(changed), 0)
+	compare(t, count(sparseAdded), 3)
+
+	// And new archetypes and entities are tracked too
+	id := world.NewId()
+	Write(world, id, C(position{}), C(radius{}), C(velocity{}))
+	Write(world, ids[1], C(velocity{}))
+	compare(t, count(changed), 1)
+	Write(world, id, C(selected{}))
+	compare(t, count(sparseAdded), 1)
+	Query1[position](world).MapId(func(id Id, p *position) {})
+	compare(t, count(changed), 4)
+}
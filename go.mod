@@ -1,5 +1,5 @@
 module github.com/ikiris/ecs
 
-go 1.18
+go 1.23
 
 require github.com/unitoftime/cod v0.0.0-20230616173404-085cf4fe3918
@@ -0,0 +1,139 @@
+package ecs
+
+import (
+	"slices"
+)
+
+// A type erased hook. It reads the component out of the column before calling the user's function
+type hookFn func(id Id, col column, index int)
+
+// The lifecycle hooks that are registered for a single component type
+type componentHooks struct {
+	onAdd    []hookFn
+	onSet    []hookFn
+	onRemove []hookFn
+}
+
+func typedHook[T any](fn func(id Id, comp T)) hookFn {
+	return func(id Id, col column, index int) {
+		fn(id, columnSlice[T](col).comp[index])
+	}
+}
+
+// Registers a function that gets called after a component of type T gets added to an entity that didn't have it yet
+func OnAdd[T any](world *World, fn func(id Id, comp T)) {
+	h := world.componentHooks(nameTyped[T]())
+	h.onAdd = append(h.onAdd, typedHook(fn))
+}
+
+// Registers a function that gets called after a component of type T gets overwritten on an entity that already had it
+func OnSet[T any](world *World, fn func(id Id, comp T)) {
+	h := world.componentHooks(nameTyped[T]())
+	h.onSet = append(h.onSet, typedHook(fn))
+}
+
+// Registers a function that gets called right before a component of type T gets removed from an entity. This includes when the entire entity gets deleted
+func OnRemove[T any](world *World, fn func(id Id, comp T)) {
+	h := world.componentHooks(nameTyped[T]())
+	h.onRemove = append(h.onRemove, typedHook(fn))
+}
+
+// Returns the hooks for the component, creating them if they don't exist
+func (world *World) componentHooks(compId CompId) *componentHooks {
+	if int(compId) >= len(world.hooks) {
+		world.hooks = append(world.hooks, make([]*componentHooks, 1+int(compId)-len(world.hooks))...)
+	}
+	if world.hooks[compId] == nil {
+		world.hooks[compId] = &componentHooks{}
+	}
+	return world.hooks[compId]
+}
+
+// Returns the hooks for the component, or nil if there aren't any
+func (world *World) getHooks(compId CompId) *componentHooks {
+	if int(compId) >= len(world.hooks) {
+		return nil
+	}
+	return world.hooks[compId]
+}
+
+// Returns true if any of the components have hooks
+func (world *World) anyHooks(comp []Component) bool {
+	if len(world.hooks) == 0 {
+		return false
+	}
+	for i := range comp {
+		if world.getHooks(comp[i].id()) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Calls the hooks with the current value of the entity's component.
+// The location is looked up for every hook, because a previous hook may have moved, changed or deleted the entity
+func (world *World) fireHooks(hooks []hookFn, id Id, compId CompId) {
+	for _, hook := range hooks {
+		col, index := world.engine.componentRow(id, compId)
+		if index < 0 {
+			return
+		}
+		hook(id, col, index)
+	}
+}
+
+// Fires the OnAdd hooks of every component in the list for each of the newly created entities
+func (world *World) fireAddHooks(ids []Id, comps []CompId) {
+	if len(world.hooks) == 0 {
+		return
+	}
+	for _, id := range ids {
+		for _, c := range comps {
+			h := world.getHooks(c)
+			if h == nil {
+				continue
+			}
+			world.fireHooks(h.onAdd, id, c)
+		}
+	}
+}
+
+// Fires the OnRemove hooks of every component in the list that the entity currently has
+func (world *World) fireRemoveHooks(id Id, comps []CompId) {
+	for _, c := range comps {
+		h := world.getHooks(c)
+		if h == nil {
+			continue
+		}
+		world.fireHooks(h.onRemove, id, c)
+	}
+}
+
+// Returns the list without duplicates, keeping the first occurrence of each component. The list is only copied if it has duplicates
+func uniqueComps(comps []CompId) []CompId {
+	for i := 1; i < len(comps); i++ {
+		for j := 0; j < i; j++ {
+			if comps[i] != comps[j] {
+				continue
+			}
+			unique := make([]CompId, 0, len(comps))
+			for _, c := range comps {
+				if !slices.Contains(unique, c) {
+					unique = append(unique, c)
+				}
+			}
+			return unique
+		}
+	}
+	return comps
+}
+
+// Returns true if the entity's OnRemove hooks are currently being fired by Delete
+func (world *World) isDeleting(id Id) bool {
+	for i := range world.deleting {
+		if world.deleting[i] == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package ecs
+
+import (
+	"testing"
+)
+
+func TestComponentHooks(t *testing.T) {
+	world := NewWorld()
+
+	added := make(map[Id]position)
+	set := make(map[Id]position)
+	removed := make(map[Id]position)
+	OnAdd(world, func(id Id, p position) { added[id] = p })
+	OnSet(world, func(id Id, p position) { set[id] = p })
+	OnRemove(world, func(id Id, p position) { removed[id] = p })
+
+	// Adding the component fires OnAdd, even when the entity already exists in a different archetype
+	id := world.NewId()
+	Write(world, id, C(velocity{1, 1, 1}))
+	compare(t, len(added), 0)
+	Write(world, id, C(position{1, 1, 1}))
+	compare(t, added[id], position{1, 1, 1})
+	compare(t, len(set), 0)
+
+	// Overwriting the component fires OnSet
+	Write(world, id, C(position{2, 2, 2}), C(velocity{2, 2, 2}))
+	compare(t, set[id], position{2, 2, 2})
+	compare(t, len(added), 1)
+
+	// Removing the component fires OnRemove with the last value
+	check(t, Remove[position](world, id))
+	compare(t, removed[id], position{2, 2, 2})
+	check(t, Remove[position](world, id))
+	compare(t, len(removed), 1)
+
+	// Listing a component twice only removes it once
+	count := 0
+	OnRemove(world, func(id Id, v velocity) { count++ })
+	check(t, world.Remove(id, velocity{}, velocity{}))
+	compare(t, count, 1)
+
+	// Deleting an entity fires OnRemove for its components
+	id2 := world.NewId()
+	Write(world, id2, C(position{3, 3, 3}))
+	compare(t, added[id2], position{3, 3, 3})
+	check(t, Delete(world, id2))
+	compare(t, removed[id2], position{3, 3, 3})
+
+	// Commands go through the same hooks
+	id3 := world.NewId()
+	cmd := NewCommand(world)
+	WriteCmd(cmd, id3, position{4, 4, 4})
+	RemoveCmd[position](cmd, id3)
+	compare(t, len(added), 2)
+	cmd.Execute()
+	compare(t, added[id3], position{4, 4, 4})
+	compare(t, removed[id3], position{4, 4, 4})
+}
+
+func TestComponentHooksModifyWorld(t *testing.T) {
+	world := NewWorld()
+
+	// A hook that writes another component moves the entity while the hooks are running
+	OnAdd(world, func(id Id, p position) {
+		Write(world, id, C(radius{p.x}))
+	})
+	count := 0
+	OnAdd(world, func(id Id, p position) {
+		count++
+		compare(t, p, position{5, 5, 5})
+	})
+
+	id := world.NewId()
+	Write(world, id, C(position{5, 5, 5}))
+	compare(t, count, 1)
+	rad, ok := Read[radius](world, id)
+	check(t, ok)
+	compare(t, rad, radius{5})
+
+	// A hook that deletes the entity stops the remaining hooks
+	OnRemove(world, func(id Id, r radius) {
+		Delete(world, id)
+	})
+	check(t, Remove[radius](world, id))
+	check(t, !world.Exists(id))
+}
+
+func TestComponentHooksUnhookedWrite(t *testing.T) {
+	world := NewWorld()
+	OnAdd(world, func(id Id, p position) {})
+
+	id := world.NewId()
+	Write(world, id, C(velocity{}))
+	comps := []Component{C(velocity{1, 1, 1})}
+
+	// Writing components without hooks doesn't need any bookkeeping
+	allocs := testing.AllocsPerRun(100, func() {
+		world.Write(id, comps...)
+	})
+	compare(t, allocs, 0.0)
+}
@@ -0,0 +1,77 @@
+package ecs
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Describes a single component of an entity, so that tools like inspectors and editors can work with components without knowing their types
+type ComponentInfo struct {
+	Id   CompId
+	Name string       // The name of the component type, or the registered name of a dynamic component
+	Type reflect.Type // The type of the component, nil for dynamic components
+	Ptr  any          // A *T that points to the component, or the []byte of a dynamic component. It has the same lifetime caveats as ReadPtr
+}
+
+// Returns every component of the entity specified at id, sorted by CompId. Returns nil if the entity doesn't exist
+func Inspect(world *World, id Id) []ComponentInfo {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
+		return nil
+	}
+
+	arch := world.engine.getArchetype(loc.archId)
+	ret := make([]ComponentInfo, 0, len(arch.comps))
+	for i, c := range arch.comps {
+		ret = append(ret, newComponentInfo(c, arch.columns[i].pointer(loc.index)))
+	}
+
+	if len(world.engine.sparse) > 0 {
+		for _, c := range world.engine.sparseComps(id) {
+			col, index := world.engine.sparse[c].row(id)
+			ret = append(ret, newComponentInfo(c, col.pointer(index)))
+		}
+		sort.Slice(ret, func(i, j int) bool {
+			return ret[i].Id < ret[j].Id
+		})
+	}
+	return ret
+}
+
+func newComponentInfo(compId CompId, ptr any) ComponentInfo {
+	meta, _ := getCompMeta(compId)
+	return ComponentInfo{
+		Id:   compId,
+		Name: meta.name,
+		Type: meta.typ,
+		Ptr:  ptr,
+	}
+}
+
+// Returns the CompId of the type. Returns false if the type was never used as a component
+func LookupCompId(t reflect.Type) (CompId, bool) {
+	compId, ok := registeredComponents.Load(t)
+	if !ok {
+		return invalidComponentId, false
+	}
+	return compId.(CompId), true
+}
+
+// Returns the CompId of the dynamic component with the specified name. Returns false if it isn't registered
+func LookupDynamic(name string) (CompId, bool) {
+	componentIdMutex.Lock()
+	defer componentIdMutex.Unlock()
+	compId, ok := dynamicComponents[name]
+	return compId, ok
+}
+
+// Returns the CompId of type T, registering it if this is the first time that T is used as a component
+func CompIdFor[T any]() CompId {
+	return nameTyped[T]()
+}
+
+// Returns the type and the name of the component. The type is nil for dynamic components. Returns false if the CompId isn't registered
+func ComponentType(compId CompId) (reflect.Type, string, bool) {
+	meta, ok := getCompMeta(compId)
+	return meta.typ, meta.name, ok
+}
@@ -0,0 +1,63 @@
+package ecs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+	armor := RegisterDynamic("test.armor", 1, 1)
+
+	id := world.NewId()
+	Write(world, id, C(position{1, 2, 3}), C(player{}), C(selected{4}), Dyn(armor, []byte{5}))
+
+	comps := Inspect(world, id)
+	compare(t, len(comps), 4)
+	for i := 1; i < len(comps); i++ {
+		check(t, comps[i-1].Id < comps[i].Id)
+	}
+
+	found := 0
+	for _, c := range comps {
+		switch c.Name {
+		case "ecs.position":
+			check(t, c.Type == reflect.TypeOf(position{}))
+			compare(t, c.Id, CompIdFor[position]())
+			compare(t, reflect.ValueOf(c.Ptr).Elem().Field(1).Float(), 2.0)
+			found++
+		case "ecs.player":
+			_, ok := c.Ptr.(*player)
+			check(t, ok)
+			found++
+		case "ecs.selected":
+			c.Ptr.(*selected).by = 40
+			found++
+		case "test.armor":
+			check(t, c.Type == nil)
+			compare(t, c.Ptr.([]byte)[0], byte(5))
+			found++
+		}
+	}
+	compare(t, found, 4)
+	sel, _ := Read[selected](world, id)
+	compare(t, sel, selected{40})
+
+	check(t, Inspect(world, InvalidEntity) == nil)
+
+	compId, ok := LookupCompId(reflect.TypeOf(position{}))
+	check(t, ok)
+	compare(t, compId, CompIdFor[position]())
+	_, ok = LookupCompId(reflect.TypeOf(struct{ unused int }{}))
+	check(t, !ok)
+
+	compId, ok = LookupDynamic("test.armor")
+	check(t, ok)
+	compare(t, compId, armor)
+
+	typ, name, ok := ComponentType(armor)
+	check(t, ok)
+	check(t, typ == nil)
+	compare(t, name, "test.armor")
+}
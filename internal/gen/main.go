@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"strconv"
 	"strings"
   "text/template"
 	_ "embed"
@@ -11,6 +12,9 @@ import (
 //go:embed view.tgo
 var viewTemplate string
 
+//go:embed spawn.tgo
+var spawnTemplate string
+
 type viewData struct {
 	Views [][]string
 }
@@ -56,6 +60,22 @@ func main() {
 			}
 			return strings.Join(ret, ", ")
 		},
+		"rowType": func(val []string) string {
+			if len(val) == 1 {
+				return "*" + val[0]
+			}
+			return "Row" + strconv.Itoa(len(val)) + "[" + strings.Join(val, ",") + "]"
+		},
+		"rowValue": func(val []string) string {
+			if len(val) == 1 {
+				return "ret" + val[0]
+			}
+			ret := make([]string, len(val))
+			for i := range val {
+				ret[i] = "ret" + val[i]
+			}
+			return "Row" + strconv.Itoa(len(val)) + "[" + strings.Join(val, ",") + "]{" + strings.Join(ret, ", ") + "}"
+		},
 		"sliceLambdaArgs": func(val []string) string {
 			ret := make([]string, len(val))
 			for i := range val {
@@ -74,4 +94,14 @@ func main() {
 	defer viewFile.Close()
 
   t.Execute(viewFile, data)
+
+	st := template.Must(template.New("SpawnTemplate").Funcs(funcs).Parse(spawnTemplate))
+
+	spawnFile, err := os.OpenFile("spawn_gen.go", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		panic(err)
+	}
+	defer spawnFile.Close()
+
+	st.Execute(spawnFile, data)
 }
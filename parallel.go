@@ -0,0 +1,102 @@
+package ecs
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// The number of rows per chunk that MapIdParallel uses if it is given a chunk size of 0 or less
+var DefaultChunkSize = 1024
+
+// A range of rows of an archetype, which is processed by a single worker
+type rowChunk struct {
+	arch       *archetype
+	start, end int
+}
+
+// Splits the rows of the archetypes into chunks of chunkSize rows and calls fn for every chunk on a pool of worker goroutines. Returns once every chunk was processed
+func parallelChunks(world *World, archIds []archetypeId, chunkSize int, fn func(arch *archetype, start, end int)) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	chunks := make([]rowChunk, 0)
+	for _, archId := range archIds {
+		arch := world.engine.getArchetype(archId)
+		rows := len(arch.lookup.id)
+		for start := 0; start < rows; start += chunkSize {
+			end := start + chunkSize
+			if end > rows {
+				end = rows
+			}
+			chunks = append(chunks, rowChunk{arch, start, end})
+		}
+	}
+
+	parallelFor(len(chunks), func(i int) {
+		fn(chunks[i].arch, chunks[i].start, chunks[i].end)
+	})
+}
+
+// Splits the ids into chunks of chunkSize ids and calls fn for every chunk on a pool of worker goroutines. Returns once every chunk was processed
+func parallelIds(ids []Id, chunkSize int, fn func(ids []Id)) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	chunks := (len(ids) + chunkSize - 1) / chunkSize
+	parallelFor(chunks, func(i int) {
+		end := (i + 1) * chunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		fn(ids[i*chunkSize : end])
+	})
+}
+
+// Calls fn for every index from 0 to n on a pool of runtime.GOMAXPROCS(0) worker goroutines. Returns once every index was processed.
+// If fn panics, the panic is re-raised on the calling goroutine after the other workers stopped
+func parallelFor(n int, fn func(i int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	var next atomic.Int64 // The next index that a worker should take
+	var stop atomic.Bool  // Set if a worker panicked, so that the others stop taking indices
+	var panicOnce sync.Once
+	var panicVal any
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicVal = r })
+					stop.Store(true)
+				}
+			}()
+
+			for !stop.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicVal != nil {
+		panic(panicVal)
+	}
+}
@@ -0,0 +1,134 @@
+package ecs
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// A DynamicQuery is a query whose components are chosen at runtime instead of by type parameters, for tools and debug consoles that don't know the component types at compile time. It isn't limited to twelve components.
+// Components are referred to by their index in the list that the query was created with
+type DynamicQuery struct {
+	world  *World
+	comps  []CompId // The components in the order that the query was created with
+	filter filterList
+	chunk  Chunk // Reused for every chunk, so that mapping doesn't allocate
+}
+
+// Creates a query of the components with the specified component filters. Dynamic components can be queried too
+func QueryDynamic(world *World, comps []CompId, filters ...Filter) *DynamicQuery {
+	q := &DynamicQuery{
+		world: world,
+		comps: append([]CompId(nil), comps...),
+	}
+
+	// Note: The filters may reorder the list that they are given, so they get a copy
+	q.filter = newFilterList(world, append([]CompId(nil), comps...), filters...)
+	q.filter.regenerate(world)
+	return q
+}
+
+// Creates a query of the component types with the specified component filters. Types that were never used as a component get registered
+func QueryTypes(world *World, types []reflect.Type, filters ...Filter) *DynamicQuery {
+	comps := make([]CompId, len(types))
+	for i := range types {
+		comps[i] = nameOfType(types[i])
+	}
+	return QueryDynamic(world, comps, filters...)
+}
+
+// Returns the components of the query, in the order that the query was created with
+func (q *DynamicQuery) Comps() []CompId {
+	return q.comps
+}
+
+// Calls the lambda once for every archetype that matched the specified filters. The chunk holds the rows of the archetype and provides access to their components.
+// The chunk is only valid inside of the lambda. The same caveats as MapId apply to modifying the world inside of the lambda
+func (q *DynamicQuery) MapChunks(lambda func(chunk *Chunk)) {
+	q.filter.regenerate(q.world)
+	tick := q.filter.beginRun(q.world)
+
+	for _, archId := range q.filter.archIds {
+		arch := q.world.engine.getArchetype(archId)
+		if len(arch.lookup.id) == len(arch.lookup.holes) {
+			continue // Skip if there are no entities
+		}
+
+		q.chunk = Chunk{
+			query: q,
+			arch:  arch,
+			tick:  tick,
+		}
+		lambda(&q.chunk)
+	}
+
+	q.filter.endRun(q.world, tick)
+}
+
+// A chunk holds the rows of a single archetype that a DynamicQuery matched
+type Chunk struct {
+	query *DynamicQuery
+	arch  *archetype
+	tick  uint64 // The tick of the query's run, which gets stamped on every component that the chunk hands out
+}
+
+// Returns the number of rows in the chunk, including the rows that must be skipped
+func (c *Chunk) Len() int {
+	return len(c.arch.lookup.id)
+}
+
+// Returns the id of the entity in the row, or InvalidEntity if the row must be skipped because it is a hole or it doesn't match the filters
+func (c *Chunk) Id(row int) Id {
+	id := c.arch.lookup.id[row]
+	if id == InvalidEntity {
+		return InvalidEntity
+	}
+	if c.query.filter.rowFilter && !c.query.filter.matchRow(c.query.world, c.arch, row) {
+		return InvalidEntity
+	}
+	return id
+}
+
+// Returns a pointer to the component at index k of the query, for the entity in the row. This is a *T for typed components, or the []byte of a dynamic component.
+// Returns nil if the entity doesn't have the component. The component is marked as changed
+func (c *Chunk) Ptr(row, k int) any {
+	compId := c.query.comps[k]
+	if s := c.query.world.engine.getSparseStorage(compId); s != nil {
+		id := c.arch.lookup.id[row]
+		col, index := s.row(id)
+		if index < 0 {
+			return nil
+		}
+		if t := s.ticks(id); t != nil {
+			t.changed = c.tick
+		}
+		return col.pointer(index)
+	}
+
+	colIdx := c.arch.columnIndex(compId)
+	if colIdx < 0 || c.arch.columns[colIdx] == nil {
+		return nil
+	}
+	if ticks := c.arch.ticks[colIdx]; ticks != nil {
+		ticks[row].changed = c.tick
+	}
+	return c.arch.columns[colIdx].pointer(row)
+}
+
+// Returns the column of the component at index k of the query, with one element for every row of the chunk. The rows that must be skipped are included, so check them with Id.
+// Returns nil if the archetype doesn't store the component, because it is optional or sparse. Panics if T isn't the type of the component, so dynamic components must be accessed with Ptr. Every component in the column is marked as changed
+func ChunkColumn[T any](c *Chunk, k int) []T {
+	compId := c.query.comps[k]
+	if compId != nameTyped[T]() {
+		panic(fmt.Sprintf("ecs: Component %d of the query isn't a %T", k, *new(T)))
+	}
+
+	colIdx := c.arch.columnIndex(compId)
+	if colIdx < 0 || c.arch.columns[colIdx] == nil {
+		return nil
+	}
+	ticks := c.arch.ticks[colIdx]
+	for i := range ticks {
+		ticks[i].changed = c.tick
+	}
+	return columnSlice[T](c.arch.columns[colIdx]).comp[:c.Len()]
+}
@@ -0,0 +1,103 @@
+package ecs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDynamicQuery(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+	health := RegisterDynamic("queryHealth", 4, 4)
+
+	ids := make([]Id, 6)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{x: float64(i)}), Dyn(health, []byte{byte(i), 0, 0, 0}))
+		if i%2 == 0 {
+			Write(world, ids[i], C(velocity{1, 1, 1}))
+		}
+		if i%3 == 0 {
+			Write(world, ids[i], C(selected{by: i}))
+		}
+	}
+	Delete(world, ids[5]) // Leaves a hole
+
+	query := QueryTypes(world, []reflect.Type{reflect.TypeOf(position{}), reflect.TypeOf(velocity{})}, Optional(velocity{}))
+	compare(t, len(query.Comps()), 2)
+	compare(t, query.Comps()[0], CompIdFor[position]())
+
+	count := 0
+	query.MapChunks(func(chunk *Chunk) {
+		pos := ChunkColumn[position](chunk, 0)
+		vel := ChunkColumn[velocity](chunk, 1)
+		compare(t, len(pos), chunk.Len())
+		for row := 0; row < chunk.Len(); row++ {
+			if chunk.Id(row) == InvalidEntity {
+				continue
+			}
+			count++
+			if vel != nil {
+				pos[row].y += vel[row].y
+			}
+		}
+	})
+	compare(t, count, 5)
+	for i, id := range ids[:5] {
+		pos, _ := Read[position](world, id)
+		if i%2 == 0 {
+			compare(t, pos.y, 1.0)
+		} else {
+			compare(t, pos.y, 0.0)
+		}
+	}
+
+	// Untyped access to typed, sparse and dynamic components
+	query = QueryDynamic(world, []CompId{CompIdFor[position](), health, CompIdFor[selected]()}, Without(velocity{}))
+	count = 0
+	query.MapChunks(func(chunk *Chunk) {
+		for row := 0; row < chunk.Len(); row++ {
+			id := chunk.Id(row)
+			if id == InvalidEntity {
+				continue
+			}
+			count++
+			pos := chunk.Ptr(row, 0).(*position)
+			data := chunk.Ptr(row, 1).([]byte)
+			compare(t, int(data[0]), int(pos.x))
+			data[0]++
+			sel := chunk.Ptr(row, 2).(*selected)
+			compare(t, sel.by, 3)
+			check(t, ChunkColumn[selected](chunk, 2) == nil) // Sparse components aren't stored in columns
+		}
+	})
+	compare(t, count, 1) // Only id 3 has selected and no velocity
+	compare(t, ReadDynamic(world, ids[3], health)[0], byte(4))
+
+	// Change filters work relative to the last run of the query
+	changed := QueryDynamic(world, []CompId{CompIdFor[velocity]()}, Changed[position]())
+	rows := func() int {
+		n := 0
+		changed.MapChunks(func(chunk *Chunk) {
+			for row := 0; row < chunk.Len(); row++ {
+				if chunk.Id(row) != InvalidEntity {
+					n++
+				}
+			}
+		})
+		return n
+	}
+	compare(t, rows(), 3)
+	compare(t, rows(), 0)
+	Write(world, ids[2], C(position{}))
+	compare(t, rows(), 1)
+
+	// Panics if the column is read with the wrong type
+	defer func() {
+		check(t, recover() != nil)
+	}()
+	changed.MapChunks(func(chunk *Chunk) {
+		ChunkColumn[position](chunk, 0)
+	})
+	t.Fatal("ChunkColumn didn't panic")
+}
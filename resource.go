@@ -0,0 +1,33 @@
+package ecs
+
+// Resources are typed singletons that are stored in the world, like the tilemap, the input state or the game clock. There can be at most one resource of each type per world
+
+// Stores the resource of type T in the world, replacing the previous one if it exists
+func SetResource[T any](world *World, val T) {
+	if world.resources == nil {
+		world.resources = make(map[any]any)
+	}
+	res, ok := world.resources[typeKey[T]{}]
+	if ok {
+		*(res.(*T)) = val
+		return
+	}
+	world.resources[typeKey[T]{}] = &val
+}
+
+// Returns a pointer to the resource of type T, or nil if the world doesn't have one.
+// The pointer stays valid until the resource is removed
+func Resource[T any](world *World) *T {
+	res, ok := world.resources[typeKey[T]{}]
+	if !ok {
+		return nil
+	}
+	return res.(*T)
+}
+
+// Removes the resource of type T from the world. Returns true if the resource existed, else returns false
+func RemoveResource[T any](world *World) bool {
+	_, ok := world.resources[typeKey[T]{}]
+	delete(world.resources, typeKey[T]{})
+	return ok
+}
@@ -0,0 +1,50 @@
+package ecs
+
+import (
+	"testing"
+)
+
+type gameClock struct {
+	frame int
+}
+
+func TestResources(t *testing.T) {
+	world := NewWorld()
+	check(t, Resource[gameClock](world) == nil)
+	check(t, !RemoveResource[gameClock](world))
+
+	SetResource(world, gameClock{1})
+	clock := Resource[gameClock](world)
+	compare(t, *clock, gameClock{1})
+
+	// Setting the resource again keeps the same pointer
+	clock.frame++
+	SetResource(world, gameClock{clock.frame + 1})
+	check(t, Resource[gameClock](world) == clock)
+	compare(t, clock.frame, 3)
+
+	// Every world has its own resources
+	world2 := NewWorld()
+	check(t, Resource[gameClock](world2) == nil)
+	SetResource(world2, gameClock{10})
+	compare(t, Resource[gameClock](world).frame, 3)
+
+	check(t, RemoveResource[gameClock](world))
+	check(t, Resource[gameClock](world) == nil)
+	compare(t, Resource[gameClock](world2).frame, 10)
+}
+
+func TestResourceCommands(t *testing.T) {
+	world := NewWorld()
+	cmd := NewCommand(world)
+
+	SetResourceCmd(cmd, gameClock{5})
+	check(t, Resource[gameClock](world) == nil)
+	cmd.Execute()
+	compare(t, *Resource[gameClock](world), gameClock{5})
+
+	RemoveResourceCmd[gameClock](cmd)
+	SetResourceCmd(cmd, gameClock{6})
+	cmd.Execute()
+	compare(t, *Resource[gameClock](world), gameClock{6})
+}
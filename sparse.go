@@ -0,0 +1,277 @@
+package ecs
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// The untyped interface of a sparseSet, so that the world can manage sparse components without knowing their type
+type sparseStorage interface {
+	row(id Id) (column, int) // Returns the dense column and the index of the entity's component, or -1 if the entity doesn't have it
+	has(id Id) bool
+	ticks(id Id) *componentTicks // Returns the change ticks of the entity's component, or nil if the entity doesn't have it or the component isn't tracked
+	track(tick uint64)           // Starts keeping the change ticks, stamping the existing components with the tick
+	remove(id Id) bool
+	clone(src, dst Id, tick uint64) // Copies the component of the src entity to the dst entity, if the src entity has it
+	shrink()
+	clear()
+	len() int
+	entities() []Id // Returns the ids of every entity that has the component
+	capacityBytes() int
+}
+
+// A sparse set stores a component outside of the archetypes. The components are packed into a dense slice, and a paged sparse array maps entity indices into it
+// Adding or removing the component only touches the set, so the entity never has to move between archetypes
+type sparseSet[T any] struct {
+	pages   []*[locPageSize]int32 // The dense index + 1 of every entity index. 0 means that the entity doesn't have the component
+	dense   *componentSlice[T]
+	ids     []Id             // The Id that owns each dense index
+	tick    []componentTicks // The change ticks of each dense index, if the component is tracked
+	tracked bool
+}
+
+func newSparseSet[T any]() *sparseSet[T] {
+	return &sparseSet[T]{
+		pages: make([]*[locPageSize]int32, 0),
+		dense: &componentSlice[T]{
+			comp: make([]T, 0),
+		},
+		ids: make([]Id, 0),
+	}
+}
+
+// Registers the component of type T to use sparse set storage in the world. Sparse components aren't part of an entity's archetype, so adding and removing them never moves the entity. This is useful for components that are added and removed frequently.
+// Iterating sparse components is slower than iterating archetype components, because views must look every entity up in the set.
+// This must be called before the component is written to any entity in the world, and before any views are created for the world.
+func RegisterSparse[T any](world *World) {
+	compId := nameTyped[T]()
+	if getSparse[T](world.engine, compId) != nil {
+		return // Already registered
+	}
+	if world.views > 0 {
+		// Note: Existing views have already compiled their filters with the component stored in archetypes, so they would never match it
+		panic(fmt.Sprintf("ecs: Component %T must be registered as sparse before any views are created", *new(T)))
+	}
+	for _, arch := range world.engine.dcr.archetypes {
+		if arch.has(compId) {
+			panic(fmt.Sprintf("ecs: Component %T is already stored in an archetype, it must be registered as sparse before it is written", *new(T)))
+		}
+	}
+
+	if int(compId) >= len(world.engine.sparse) {
+		world.engine.sparse = append(world.engine.sparse, make([]sparseStorage, 1+int(compId)-len(world.engine.sparse))...)
+	}
+	world.engine.sparse[compId] = newSparseSet[T]()
+}
+
+// Returns the sparse set of the component, or nil if the component isn't sparse
+func getSparse[T any](e *archEngine, compId CompId) *sparseSet[T] {
+	s := e.getSparseStorage(compId)
+	if s == nil {
+		return nil
+	}
+	return s.(*sparseSet[T])
+}
+
+// Returns the index of the entity's component in the dense slice, or -1 if the entity doesn't have it
+func (s *sparseSet[T]) index(id Id) int {
+	idx := id.Index()
+	p := int(idx >> locPageBits)
+	if p >= len(s.pages) || s.pages[p] == nil {
+		return -1
+	}
+	dense := int(s.pages[p][idx&(locPageSize-1)]) - 1
+	if dense < 0 || s.ids[dense] != id {
+		return -1
+	}
+	return dense
+}
+
+func (s *sparseSet[T]) setIndex(id Id, dense int) {
+	idx := id.Index()
+	p := int(idx >> locPageBits)
+	if p >= len(s.pages) {
+		s.pages = append(s.pages, make([]*[locPageSize]int32, p+1-len(s.pages))...)
+	}
+	if s.pages[p] == nil {
+		s.pages[p] = new([locPageSize]int32)
+	}
+	s.pages[p][idx&(locPageSize-1)] = int32(dense + 1)
+}
+
+// Returns a pointer to the entity's component, or nil if the entity doesn't have it
+func (s *sparseSet[T]) get(id Id) *T {
+	dense := s.index(id)
+	if dense < 0 {
+		return nil
+	}
+	return &s.dense.comp[dense]
+}
+
+// Returns a pointer to the entity's component and marks it as changed, or nil if the entity doesn't have it
+func (s *sparseSet[T]) getChanged(id Id, tick uint64) *T {
+	dense := s.index(id)
+	if dense < 0 {
+		return nil
+	}
+	if s.tracked {
+		s.tick[dense].changed = tick
+	}
+	return &s.dense.comp[dense]
+}
+
+// Writes the entity's component, adding it if the entity doesn't have it yet
+func (s *sparseSet[T]) set(id Id, val T, tick uint64) {
+	dense := s.index(id)
+	if dense >= 0 {
+		s.dense.comp[dense] = val
+		if s.tracked {
+			s.tick[dense].changed = tick
+		}
+		return
+	}
+
+	s.dense.comp = append(s.dense.comp, val)
+	s.ids = append(s.ids, id)
+	if s.tracked {
+		s.tick = append(s.tick, componentTicks{tick, tick})
+	}
+	s.setIndex(id, len(s.ids)-1)
+}
+
+func (s *sparseSet[T]) row(id Id) (column, int) {
+	return s.dense, s.index(id)
+}
+
+func (s *sparseSet[T]) has(id Id) bool {
+	return s.index(id) >= 0
+}
+
+func (s *sparseSet[T]) ticks(id Id) *componentTicks {
+	dense := s.index(id)
+	if dense < 0 || !s.tracked {
+		return nil
+	}
+	return &s.tick[dense]
+}
+
+func (s *sparseSet[T]) track(tick uint64) {
+	s.tracked = true
+	s.tick = make([]componentTicks, len(s.ids))
+	for i := range s.tick {
+		s.tick[i] = componentTicks{tick, tick}
+	}
+}
+
+// Removes the entity's component by moving the last component into its place. Returns false if the entity didn't have it
+func (s *sparseSet[T]) remove(id Id) bool {
+	dense := s.index(id)
+	if dense < 0 {
+		return false
+	}
+
+	lastIndex := len(s.ids) - 1
+	lastId := s.ids[lastIndex]
+	s.dense.Delete(dense)
+	s.ids[dense] = lastId
+	s.ids = s.ids[:lastIndex]
+	if s.tracked {
+		s.tick[dense] = s.tick[lastIndex]
+		s.tick = s.tick[:lastIndex]
+	}
+	if lastId != id {
+		s.setIndex(lastId, dense)
+	}
+
+	idx := id.Index()
+	s.pages[idx>>locPageBits][idx&(locPageSize-1)] = 0
+	return true
+}
+
+func (s *sparseSet[T]) clone(src, dst Id, tick uint64) {
+	val := s.get(src)
+	if val == nil {
+		return
+	}
+	s.set(dst, copyComponent(*val), tick)
+}
+
+// Removes every component but keeps the capacity
+func (s *sparseSet[T]) clear() {
+	for _, id := range s.ids {
+		idx := id.Index()
+		s.pages[idx>>locPageBits][idx&(locPageSize-1)] = 0
+	}
+	s.ids = s.ids[:0]
+	s.tick = s.tick[:0]
+	s.dense.clear()
+}
+
+func (s *sparseSet[T]) len() int {
+	return len(s.ids)
+}
+
+func (s *sparseSet[T]) entities() []Id {
+	return s.ids
+}
+
+// Returns the number of bytes allocated by the set
+func (s *sparseSet[T]) capacityBytes() int {
+	var id Id
+	var index int32
+	var ticks componentTicks
+	pageBytes := 0
+	for _, page := range s.pages {
+		if page != nil {
+			pageBytes += locPageSize * int(unsafe.Sizeof(index))
+		}
+	}
+	return s.dense.capacityBytes() + cap(s.ids)*int(unsafe.Sizeof(id)) + cap(s.tick)*int(unsafe.Sizeof(ticks)) + pageBytes
+}
+
+func (s *sparseSet[T]) shrink() {
+	s.dense.shrink()
+	s.ids = shrinkSlice(s.ids)
+	s.tick = shrinkSlice(s.tick)
+}
+
+// Returns the sparse storage of the component, or nil if the component isn't sparse
+func (e *archEngine) getSparseStorage(compId CompId) sparseStorage {
+	if int(compId) >= len(e.sparse) {
+		return nil
+	}
+	return e.sparse[compId]
+}
+
+// Returns true if any of the components use sparse storage
+func (e *archEngine) anySparse(comp []Component) bool {
+	if len(e.sparse) == 0 {
+		return false
+	}
+	for i := range comp {
+		if e.getSparseStorage(comp[i].id()) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Removes the entity from every sparse set
+func (e *archEngine) removeSparse(id Id) {
+	for _, s := range e.sparse {
+		if s != nil {
+			s.remove(id)
+		}
+	}
+}
+
+// Returns the sparse components that the entity has
+func (e *archEngine) sparseComps(id Id) []CompId {
+	ret := make([]CompId, 0)
+	for compId, s := range e.sparse {
+		if s != nil && s.has(id) {
+			ret = append(ret, CompId(compId))
+		}
+	}
+	return ret
+}
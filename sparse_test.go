@@ -0,0 +1,194 @@
+package ecs
+
+import (
+	"testing"
+)
+
+type selected struct {
+	by int
+}
+
+func TestSparseComponents(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		id := world.NewId()
+		Write(world, id, C(position{float64(i), 0, 0}))
+		ids = append(ids, id)
+	}
+	archetypes := len(world.engine.dcr.archetypes)
+
+	// Toggling a sparse component doesn't move the entity
+	loc := *world.engine.locs.get(ids[3])
+	Write(world, ids[3], C(selected{3}))
+	Write(world, ids[5], C(selected{5}), C(velocity{}))
+	compare(t, *world.engine.locs.get(ids[3]), loc)
+	compare(t, len(world.engine.dcr.archetypes), archetypes+1)
+
+	sel, ok := Read[selected](world, ids[3])
+	check(t, ok)
+	compare(t, sel, selected{3})
+	_, ok = Read[selected](world, ids[4])
+	check(t, !ok)
+	ReadPtr[selected](world, ids[3]).by = 33
+
+	// Sparse components can be queried and filtered
+	count := 0
+	Query2[position, selected](world).MapId(func(id Id, p *position, s *selected) {
+		check(t, id == ids[3] || id == ids[5])
+		count++
+	})
+	compare(t, count, 2)
+
+	count = 0
+	Query1[position](world, With(selected{})).MapId(func(id Id, p *position) {
+		count++
+	})
+	compare(t, count, 2)
+
+	count = 0
+	Query2[position, selected](world, Optional(selected{})).MapId(func(id Id, p *position, s *selected) {
+		if s != nil {
+			count += s.by
+		}
+	})
+	compare(t, count, 38)
+
+	query := Query2[position, selected](world)
+	p, s := query.Read(ids[3])
+	compare(t, *p, position{3, 0, 0})
+	compare(t, *s, selected{33})
+	_, s = query.Read(ids[4])
+	check(t, s == nil)
+
+	// An entity can exist with only sparse components
+	only := world.NewId()
+	Write(world, only, C(selected{-1}))
+	check(t, world.Exists(only))
+	compare(t, len(world.engine.ReadEntity(world.engine.locs.get(only).archId, only).comp), 1)
+
+	// Removing and deleting
+	check(t, Remove[selected](world, ids[3]))
+	_, ok = Read[selected](world, ids[3])
+	check(t, !ok)
+	_, ok = Read[position](world, ids[3])
+	check(t, ok)
+	compare(t, *world.engine.locs.get(ids[3]), loc)
+
+	removed := 0
+	OnRemove(world, func(id Id, s selected) {
+		removed++
+	})
+	Delete(world, ids[5])
+	compare(t, removed, 1)
+	sel, ok = Read[selected](world, only)
+	check(t, ok)
+	compare(t, sel, selected{-1})
+
+	// A recycled id doesn't inherit sparse components
+	recycled := world.NewId()
+	compare(t, recycled.Index(), ids[5].Index())
+	Write(world, recycled, C(position{}))
+	_, ok = Read[selected](world, recycled)
+	check(t, !ok)
+}
+
+func TestSparseRegisterAfterWrite(t *testing.T) {
+	world := NewWorld()
+	Write(world, world.NewId(), C(selected{}))
+
+	defer func() {
+		check(t, recover() != nil)
+	}()
+	RegisterSparse[selected](world)
+}
+
+func TestSparseRegisterAfterView(t *testing.T) {
+	world := NewWorld()
+	Query1[position](world, With(selected{}))
+
+	defer func() {
+		check(t, recover() != nil)
+	}()
+	RegisterSparse[selected](world)
+}
+
+func TestSparseDrivenViews(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	ids := make([]Id, 1000)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{x: float64(i)}))
+		if i%2 == 0 {
+			Write(world, ids[i], C(velocity{}))
+		}
+		if i%100 == 0 {
+			Write(world, ids[i], C(selected{by: i}))
+		}
+	}
+
+	// Views that require a sparse component only visit the entities in its set
+	query := Query2[position, selected](world, Without(velocity{}))
+	check(t, query.filter.sparseDriven())
+	check(t, !Query1[position](world, Optional(selected{})).filter.sparseDriven())
+	check(t, !Query1[position](world, AnyOf(With(selected{}), With(velocity{}))).filter.sparseDriven())
+	check(t, Query1[position](world, AllOf(With(selected{}))).filter.sparseDriven())
+
+	Write(world, ids[301], C(selected{by: 301}))
+	Write(world, ids[501], C(selected{by: 501}))
+	visited := make(map[Id]int)
+	query.MapId(func(id Id, p *position, s *selected) {
+		compare(t, int(p.x), s.by)
+		visited[id]++
+	})
+	compare(t, len(visited), 2)
+
+	// Deleting and removing entities during the iteration doesn't skip or repeat any
+	for i := 0; i < 10; i++ {
+		Write(world, ids[i*100+1], C(selected{by: i*100 + 1}))
+	}
+	visited = make(map[Id]int)
+	query.MapId(func(id Id, p *position, s *selected) {
+		visited[id]++
+		if id == ids[101] {
+			Delete(world, ids[901])
+			Remove[selected](world, ids[701])
+			Delete(world, id)
+		}
+	})
+	for id, n := range visited {
+		compare(t, n, 1)
+		check(t, id != ids[901] && id != ids[701])
+	}
+	compare(t, len(visited), 8)
+
+	// All and MapIdParallel iterate the set too
+	count := 0
+	for id, row := range query.All() {
+		compare(t, int(row.A.x), row.B.by)
+		check(t, world.Exists(id))
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	compare(t, count, 3)
+	var total int
+	query.MapIdParallel(2, func(id Id, p *position, s *selected) {
+		s.by++
+	})
+	query.MapId(func(id Id, p *position, s *selected) {
+		total += s.by - int(p.x)
+	})
+	compare(t, total, 7)
+
+	// Iterating doesn't allocate
+	allocs := testing.AllocsPerRun(100, func() {
+		query.MapId(func(id Id, p *position, s *selected) {})
+	})
+	compare(t, allocs, 0.0)
+}
@@ -0,0 +1,435 @@
+package ecs
+
+// Warning: This is an autogenerated file. Do not modify!!
+
+// Spawns n new entities with the components A. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch1[A any](world *World, n int, lambda func(index int, a *A)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	comps := []CompId{compA}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch2[A, B any](world *World, n int, lambda func(index int, a *A, b *B)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	comps := []CompId{compA, compB}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch3[A, B, C any](world *World, n int, lambda func(index int, a *A, b *B, c *C)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	comps := []CompId{compA, compB, compC}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch4[A, B, C, D any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	comps := []CompId{compA, compB, compC, compD}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch5[A, B, C, D, E any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	comps := []CompId{compA, compB, compC, compD, compE}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch6[A, B, C, D, E, F any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F, G. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch7[A, B, C, D, E, F, G any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	compG := nameTyped[G]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF, compG}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+	sliceG := growColumn[G](arch, compG, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index], &sliceG[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F, G, H. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch8[A, B, C, D, E, F, G, H any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	compG := nameTyped[G]()
+	compH := nameTyped[H]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF, compG, compH}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+	sliceG := growColumn[G](arch, compG, start, n)
+	sliceH := growColumn[H](arch, compH, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index], &sliceG[index], &sliceH[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F, G, H, I. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch9[A, B, C, D, E, F, G, H, I any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	compG := nameTyped[G]()
+	compH := nameTyped[H]()
+	compI := nameTyped[I]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF, compG, compH, compI}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+	sliceG := growColumn[G](arch, compG, start, n)
+	sliceH := growColumn[H](arch, compH, start, n)
+	sliceI := growColumn[I](arch, compI, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index], &sliceG[index], &sliceH[index], &sliceI[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F, G, H, I, J. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch10[A, B, C, D, E, F, G, H, I, J any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	compG := nameTyped[G]()
+	compH := nameTyped[H]()
+	compI := nameTyped[I]()
+	compJ := nameTyped[J]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF, compG, compH, compI, compJ}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+	sliceG := growColumn[G](arch, compG, start, n)
+	sliceH := growColumn[H](arch, compH, start, n)
+	sliceI := growColumn[I](arch, compI, start, n)
+	sliceJ := growColumn[J](arch, compJ, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index], &sliceG[index], &sliceH[index], &sliceI[index], &sliceJ[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F, G, H, I, J, K. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch11[A, B, C, D, E, F, G, H, I, J, K any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	compG := nameTyped[G]()
+	compH := nameTyped[H]()
+	compI := nameTyped[I]()
+	compJ := nameTyped[J]()
+	compK := nameTyped[K]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF, compG, compH, compI, compJ, compK}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+	sliceG := growColumn[G](arch, compG, start, n)
+	sliceH := growColumn[H](arch, compH, start, n)
+	sliceI := growColumn[I](arch, compI, start, n)
+	sliceJ := growColumn[J](arch, compJ, start, n)
+	sliceK := growColumn[K](arch, compK, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index], &sliceG[index], &sliceH[index], &sliceI[index], &sliceJ[index], &sliceK[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
+
+// Spawns n new entities with the components A, B, C, D, E, F, G, H, I, J, K, L. The archetype is resolved once and every column grows once for the entire batch.
+// The lambda is called once per entity with pointers to its zeroed components, so that they can be initialized in place. The lambda may be nil.
+// Returns the ids of the new entities. The pointers are only valid inside of the lambda, and the lambda must not modify the world.
+func SpawnBatch12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, n int, lambda func(index int, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	compA := nameTyped[A]()
+	compB := nameTyped[B]()
+	compC := nameTyped[C]()
+	compD := nameTyped[D]()
+	compE := nameTyped[E]()
+	compF := nameTyped[F]()
+	compG := nameTyped[G]()
+	compH := nameTyped[H]()
+	compI := nameTyped[I]()
+	compJ := nameTyped[J]()
+	compK := nameTyped[K]()
+	compL := nameTyped[L]()
+	comps := []CompId{compA, compB, compC, compD, compE, compF, compG, compH, compI, compJ, compK, compL}
+
+	ids, arch, start := world.spawnBatch(n, comps)
+
+	sliceA := growColumn[A](arch, compA, start, n)
+	sliceB := growColumn[B](arch, compB, start, n)
+	sliceC := growColumn[C](arch, compC, start, n)
+	sliceD := growColumn[D](arch, compD, start, n)
+	sliceE := growColumn[E](arch, compE, start, n)
+	sliceF := growColumn[F](arch, compF, start, n)
+	sliceG := growColumn[G](arch, compG, start, n)
+	sliceH := growColumn[H](arch, compH, start, n)
+	sliceI := growColumn[I](arch, compI, start, n)
+	sliceJ := growColumn[J](arch, compJ, start, n)
+	sliceK := growColumn[K](arch, compK, start, n)
+	sliceL := growColumn[L](arch, compL, start, n)
+
+	if lambda != nil {
+		for index := 0; index < n; index++ {
+			lambda(index, &sliceA[index], &sliceB[index], &sliceC[index], &sliceD[index], &sliceE[index], &sliceF[index], &sliceG[index], &sliceH[index], &sliceI[index], &sliceJ[index], &sliceK[index], &sliceL[index])
+		}
+	}
+
+	world.fireAddHooks(ids, comps)
+	return ids
+}
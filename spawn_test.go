@@ -0,0 +1,79 @@
+package ecs
+
+import (
+	"testing"
+)
+
+func TestSpawnBatch(t *testing.T) {
+	world := NewWorld()
+
+	// An entity in the same archetype from before the batch
+	first := world.NewId()
+	Write(world, first, C(position{-1, -1, -1}), C(velocity{-1, -1, -1}))
+
+	ids := SpawnBatch2(world, 1000, func(i int, p *position, v *velocity) {
+		compare(t, *p, position{}) // Components start zeroed
+		*p = position{float64(i), 0, 0}
+		v.x = float64(2 * i)
+	})
+	compare(t, len(ids), 1000)
+
+	for i, id := range ids {
+		check(t, world.IsAlive(id))
+		pos, ok := Read[position](world, id)
+		check(t, ok)
+		compare(t, pos, position{float64(i), 0, 0})
+		vel, ok := Read[velocity](world, id)
+		check(t, ok)
+		compare(t, vel, velocity{float64(2 * i), 0, 0})
+	}
+	pos, ok := Read[position](world, first)
+	check(t, ok)
+	compare(t, pos, position{-1, -1, -1})
+
+	count := 0
+	Query2[velocity, position](world).MapId(func(id Id, v *velocity, p *position) {
+		count++
+	})
+	compare(t, count, 1001)
+
+	// Deleted values must not leak into a later batch that reuses the column's capacity
+	for _, id := range ids {
+		Delete(world, id)
+	}
+	world.Compact()
+	OnAdd(world, func(id Id, p position) {
+		compare(t, p, position{})
+		count++
+	})
+	count = 0
+	ids = SpawnBatch2[position, velocity](world, 10, nil)
+	compare(t, count, 10)
+	for _, id := range ids {
+		vel, ok := Read[velocity](world, id)
+		check(t, ok)
+		compare(t, vel, velocity{})
+	}
+
+	compare(t, len(SpawnBatch1[radius](world, 0, nil)), 0)
+}
+
+func TestSpawnBatchInvalid(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+	next := world.NewId()
+
+	// Returns true if the spawn panicked
+	panics := func(spawn func()) (ok bool) {
+		defer func() {
+			ok = recover() != nil
+		}()
+		spawn()
+		return false
+	}
+	check(t, panics(func() { SpawnBatch2[position, selected](world, 10, nil) }))
+	check(t, panics(func() { SpawnBatch2[position, position](world, 10, nil) }))
+
+	// The ids weren't reserved
+	compare(t, world.NewId(), next+1)
+}
@@ -0,0 +1,85 @@
+package ecs
+
+import (
+	"unsafe"
+)
+
+// A snapshot of what a world holds and how much memory it uses
+type WorldStats struct {
+	Entities   int              // The number of live entities
+	FreeIds    int              // The number of deleted Ids waiting to be recycled by NewId
+	Views      int              // The number of views that were created for the world
+	Generation int              // The number of archetypes that the world has created. Views are updated incrementally whenever this grows
+	Archetypes []ArchetypeStats // Every archetype, ordered by when it was created
+	Sparse     []SparseStats    // Every component that was registered with sparse storage
+}
+
+// Describes the rows and memory usage of a single archetype
+type ArchetypeStats struct {
+	Components  []string // The type names of the components in the archetype
+	Rows        int      // The number of rows, including holes
+	Holes       int      // The number of rows that belong to deleted entities and haven't been compacted yet
+	Efficiency  float64  // The fraction of rows that hold live entities, 1 if the archetype is empty
+	ColumnBytes int      // The number of bytes allocated by the columns, their change ticks and the Id list, including unused capacity
+}
+
+// Describes the memory usage of a sparse component
+type SparseStats struct {
+	Component     string // The type name of the component
+	Count         int    // The number of entities that have the component
+	CapacityBytes int    // The number of bytes allocated by the set, including unused capacity
+}
+
+// Returns statistics about the entities in the world and the memory used to store them
+func (world *World) Stats() WorldStats {
+	stats := WorldStats{
+		FreeIds:    len(world.freeIds),
+		Views:      world.views,
+		Generation: len(world.engine.dcr.archetypes),
+		Archetypes: make([]ArchetypeStats, 0, len(world.engine.dcr.archetypes)),
+		Sparse:     make([]SparseStats, 0),
+	}
+
+	var id Id
+	var hole int
+	for _, arch := range world.engine.dcr.archetypes {
+		lookup := arch.lookup
+		archStats := ArchetypeStats{
+			Components:  make([]string, len(arch.comps)),
+			Rows:        len(lookup.id),
+			Holes:       len(lookup.holes),
+			Efficiency:  1,
+			ColumnBytes: cap(lookup.id)*int(unsafe.Sizeof(id)) + cap(lookup.holes)*int(unsafe.Sizeof(hole)),
+		}
+		for i, c := range arch.comps {
+			meta, _ := getCompMeta(c)
+			archStats.Components[i] = meta.name
+		}
+		if archStats.Rows > 0 {
+			archStats.Efficiency = 1.0 - float64(archStats.Holes)/float64(archStats.Rows)
+		}
+		for i, col := range arch.columns {
+			if col != nil {
+				archStats.ColumnBytes += col.capacityBytes()
+			}
+			archStats.ColumnBytes += cap(arch.ticks[i]) * int(unsafe.Sizeof(componentTicks{}))
+		}
+
+		stats.Entities += archStats.Rows - archStats.Holes
+		stats.Archetypes = append(stats.Archetypes, archStats)
+	}
+
+	for compId, s := range world.engine.sparse {
+		if s == nil {
+			continue
+		}
+		meta, _ := getCompMeta(CompId(compId))
+		stats.Sparse = append(stats.Sparse, SparseStats{
+			Component:     meta.name,
+			Count:         s.len(),
+			CapacityBytes: s.capacityBytes(),
+		})
+	}
+
+	return stats
+}
@@ -0,0 +1,51 @@
+package ecs
+
+import (
+	"testing"
+)
+
+func TestWorldStats(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+	Query1[position](world)
+
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		id := world.NewId()
+		Write(world, id, C(position{}), C(velocity{}))
+		ids = append(ids, id)
+	}
+	Write(world, ids[0], C(selected{}))
+	Delete(world, ids[1])
+	Delete(world, ids[2])
+
+	stats := world.Stats()
+	compare(t, stats.Entities, 8)
+	compare(t, stats.FreeIds, 2)
+	compare(t, stats.Views, 1)
+	compare(t, stats.Generation, 2)
+	compare(t, len(stats.Archetypes), 2)
+
+	// The root archetype is always first
+	compare(t, len(stats.Archetypes[0].Components), 0)
+	compare(t, stats.Archetypes[0].Efficiency, 1.0)
+
+	arch := stats.Archetypes[1]
+	compare(t, len(arch.Components), 2)
+	compare(t, arch.Components[0], "ecs.position")
+	compare(t, arch.Components[1], "ecs.velocity")
+	compare(t, arch.Rows, 10)
+	compare(t, arch.Holes, 2)
+	compare(t, arch.Efficiency, 0.8)
+	check(t, arch.ColumnBytes >= 10*(8+2*24))
+
+	compare(t, len(stats.Sparse), 1)
+	compare(t, stats.Sparse[0].Component, "ecs.selected")
+	compare(t, stats.Sparse[0].Count, 1)
+	check(t, stats.Sparse[0].CapacityBytes > 0)
+
+	world.Compact()
+	stats = world.Stats()
+	compare(t, stats.Archetypes[1].Rows, 8)
+	compare(t, stats.Archetypes[1].Efficiency, 1.0)
+}
@@ -0,0 +1,150 @@
+package ecs
+
+import (
+	"sort"
+)
+
+// Components that store entity Ids can implement IdRemapper, so that their Ids get rewritten when their entity is transferred to a different world.
+// RemapIds should replace every stored Id with the result of calling remap on it
+type IdRemapper interface {
+	RemapIds(remap func(Id) Id)
+}
+
+// Rewrites the Ids inside of the component if it implements IdRemapper
+func remapComponent[T any](val *T, remap func(Id) Id) {
+	if r, ok := any(val).(IdRemapper); ok {
+		r.RemapIds(remap)
+	}
+}
+
+// Moves the entity specified at id from the src world to the dst world. The entity gets a new Id from the dst world's Id range.
+// Returns the new Id, or InvalidEntity if the entity doesn't exist in the src world.
+// See TransferAll for how Ids stored inside of components are rewritten
+func Transfer(src, dst *World, id Id) Id {
+	newId, ok := TransferAll(src, dst, id)[id]
+	if !ok {
+		return InvalidEntity
+	}
+	return newId
+}
+
+// Moves all of the entities from the src world to the dst world. Every entity gets a new Id from the dst world's Id range.
+// Returns a mapping from the old Ids to the new Ids. Entities that don't exist in the src world are skipped.
+// Components that implement IdRemapper get their Ids rewritten through the mapping, so that entities which reference each other still do after the transfer. Ids of entities that weren't transferred are left as they are.
+// The src world fires the OnRemove hooks of the entities and the dst world fires their OnAdd hooks
+func TransferAll(src, dst *World, ids ...Id) map[Id]Id {
+	if src == dst {
+		panic("ecs: Can't transfer entities to the same world")
+	}
+
+	mapping := make(map[Id]Id, len(ids))
+	for _, id := range ids {
+		if !src.Exists(id) {
+			continue
+		}
+		if _, ok := mapping[id]; ok {
+			continue
+		}
+		mapping[id] = dst.NewId()
+	}
+	remap := func(id Id) Id {
+		newId, ok := mapping[id]
+		if !ok {
+			return id
+		}
+		return newId
+	}
+
+	moved := make([]Id, 0, len(mapping))
+	for _, id := range ids {
+		newId, ok := mapping[id]
+		if !ok || dst.Exists(newId) {
+			continue // Skip missing and duplicate ids
+		}
+
+		loc := src.engine.locs.get(id)
+		src.engine.transferArch(dst.engine, loc.archId, id, newId, remap)
+		moved = append(moved, id)
+	}
+
+	for _, id := range moved {
+		Delete(src, id)
+	}
+
+	if len(dst.hooks) > 0 {
+		for _, id := range moved {
+			newId := mapping[id]
+			loc := dst.engine.locs.get(newId)
+			comps := dst.engine.getArchetype(loc.archId).comps
+			if len(dst.engine.sparse) > 0 {
+				comps = append(dst.engine.sparseComps(newId), comps...)
+			}
+			dst.fireAddHooks([]Id{newId}, comps)
+		}
+	}
+
+	return mapping
+}
+
+// Copies every component of the entity, including its sparse components, into the dst engine, then remaps the Ids inside of them.
+// The dst archetype is worked out up front, so that the entity is placed into the dst world only once
+func (e *archEngine) transferArch(dst *archEngine, archId archetypeId, srcId, dstId Id, remap func(Id) Id) {
+	srcArch := e.getArchetype(archId)
+	srcIndex := e.locs.get(srcId).index
+
+	// The components that the src world stores in sparse sets
+	sparse := make([]Component, 0)
+	for _, s := range e.sparse {
+		if s == nil {
+			continue
+		}
+		if col, index := s.row(srcId); index >= 0 {
+			sparse = append(sparse, col.component(index))
+		}
+	}
+
+	// Component ids are shared by every world, so the dst archetype holds the same components, except that the worlds may store different ones as sparse
+	comps := make([]CompId, 0, len(srcArch.comps)+len(sparse))
+	for _, c := range srcArch.comps {
+		if dst.getSparseStorage(c) == nil {
+			comps = append(comps, c)
+		}
+	}
+	for _, c := range sparse {
+		if dst.getSparseStorage(c.id()) == nil {
+			comps = append(comps, c.id())
+		}
+	}
+	sort.Slice(comps, func(i, j int) bool {
+		return comps[i] < comps[j]
+	})
+	dstArchId := dst.dcr.getArchetypeId(comps)
+	dstIndex := dst.allocate(dstArchId, dstId)
+	dstArch := dst.getArchetype(dstArchId)
+
+	for i, srcCol := range srcArch.columns {
+		compId := srcArch.comps[i]
+		if dst.getSparseStorage(compId) != nil {
+			srcCol.component(srcIndex).writeSparse(dst, dstId)
+		} else {
+			j := dstArch.columnIndex(compId)
+			if dstArch.columns[j] == nil {
+				dstArch.columns[j] = srcCol.newColumn()
+			}
+			dstArch.columns[j].moveRow(srcCol, srcIndex, dstIndex)
+			dstArch.stamp(j, dstIndex, dst.tick)
+		}
+		col, index := dst.componentRow(dstId, compId)
+		col.remapRow(index, remap)
+	}
+
+	for _, c := range sparse {
+		if dst.getSparseStorage(c.id()) != nil {
+			c.writeSparse(dst, dstId)
+		} else {
+			c.write(dst, dstArchId, dstId) // The entity is already in the archetype, so this doesn't move it
+		}
+		col, index := dst.componentRow(dstId, c.id())
+		col.remapRow(index, remap)
+	}
+}
@@ -0,0 +1,82 @@
+package ecs
+
+import (
+	"testing"
+)
+
+type target struct {
+	id Id
+}
+
+func (t *target) RemapIds(remap func(Id) Id) {
+	t.id = remap(t.id)
+}
+
+func TestTransfer(t *testing.T) {
+	lobby := NewWorld()
+	match := NewWorld()
+	match.SetIdRange(1000, 2000)
+	RegisterSparse[selected](lobby)
+
+	player := lobby.NewId()
+	pet := lobby.NewId()
+	other := lobby.NewId()
+	Write(lobby, player, C(position{1, 1, 1}), C(selected{1}))
+	Write(lobby, pet, C(position{2, 2, 2}), C(target{player}))
+	Write(lobby, other, C(target{player}))
+
+	removed := 0
+	OnRemove(lobby, func(id Id, p position) {
+		removed++
+	})
+	added := 0
+	OnAdd(match, func(id Id, p position) {
+		added++
+	})
+
+	mapping := TransferAll(lobby, match, player, pet, InvalidEntity)
+	compare(t, len(mapping), 2)
+	compare(t, removed, 2)
+	compare(t, added, 2)
+
+	// The entities left the src world
+	check(t, !lobby.Exists(player))
+	check(t, !lobby.Exists(pet))
+
+	// And got new ids from the range of the dst world
+	newPlayer := mapping[player]
+	newPet := mapping[pet]
+	check(t, newPlayer.Index() >= 1000 && newPlayer.Index() < 2000)
+	check(t, newPet.Index() >= 1000 && newPet.Index() < 2000)
+
+	pos, ok := Read[position](match, newPlayer)
+	check(t, ok)
+	compare(t, pos, position{1, 1, 1})
+	sel, ok := Read[selected](match, newPlayer) // The dst world doesn't store this as sparse
+	check(t, ok)
+	compare(t, sel, selected{1})
+
+	// The entity is placed into its final dst archetype at once, so it doesn't leave holes behind
+	for _, arch := range match.Stats().Archetypes {
+		compare(t, arch.Holes, 0)
+	}
+
+	// Ids of transferred entities are remapped
+	tgt, ok := Read[target](match, newPet)
+	check(t, ok)
+	compare(t, tgt.id, newPlayer)
+
+	// Entities that stayed behind are untouched
+	tgt, ok = Read[target](lobby, other)
+	check(t, ok)
+	compare(t, tgt.id, player)
+
+	// Transfer it back
+	back := Transfer(match, lobby, newPlayer)
+	check(t, back != InvalidEntity)
+	check(t, !match.Exists(newPlayer))
+	sel, ok = Read[selected](lobby, back)
+	check(t, ok)
+	compare(t, sel, selected{1})
+	compare(t, Transfer(match, lobby, newPlayer), InvalidEntity)
+}
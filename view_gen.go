@@ -1,5 +1,11 @@
 package ecs
 
+// Warning: This is an autogenerated file. Do not modify!!
+
+import (
+	"iter"
+)
+
 // --------------------------------------------------------------------------------
 // - View 1
 // --------------------------------------------------------------------------------
@@ -9,29 +15,25 @@ type View1[A any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
+	compA CompId
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query1[A any](world *World, filters ...Filter) *View1[A] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-
-	var AA A
-
-	comps := []componentId{
-
-		name(AA),
+		nameTyped[A](),
 	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
 
 	v := &View1[A]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
+		compA: comps[0],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -39,51 +41,93 @@ func Query1[A any](world *World, filters ...Filter) *View1[A] {
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View1[A]) Read(id Id) *A {
 	if id == InvalidEntity {
 		return nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
 
 	return retA
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -93,14 +137,46 @@ func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 		}
 
 		retA = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA)
 		}
 
@@ -133,6 +209,7 @@ func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -157,29 +234,283 @@ func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View1[A]) MapIdParallel(chunkSize int, lambda func(id Id, a *A)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+
+				lambda(id, retA)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a pointer to its component, so that the loop can break or return early:
+//
+//	for id, a := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View1[A]) All() iter.Seq2[Id, *A] {
+	return func(yield func(Id, *A) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+
+				if !yield(id, retA) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+
+			retA = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], retA) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+
+				if !yield(ids[idx], retA) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View1[A]) MapSlices(lambda func(id []Id, a []A)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
 	sliceListA := make([][]A, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -187,6 +518,8 @@ func (v *View1[A]) MapSlices(lambda func(id []Id, a []A)) {
 			sliceListA[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -198,34 +531,34 @@ type View2[A, B any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
+	compA CompId
+	compB CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View2
+type Row2[A, B any] struct {
+	A *A
+	B *B
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query2[A, B any](world *World, filters ...Filter) *View2[A, B] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-
-	var AA A
-	var BB B
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
+		nameTyped[A](),
+		nameTyped[B](),
 	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
 
 	v := &View2[A, B]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
-		storageB: storageB,
+		compA: comps[0],
+		compB: comps[1],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -233,61 +566,122 @@ func Query2[A, B any](world *World, filters ...Filter) *View2[A, B] {
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View2[A, B]) Read(id Id) (*A, *B) {
 	if id == InvalidEntity {
 		return nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
 
 	return retA, retB
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -302,17 +696,60 @@ func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 
 		retA = nil
 		retB = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB)
 		}
 
@@ -345,6 +782,7 @@ func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -369,9 +807,343 @@ func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View2[A, B]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row2 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View2[A, B]) All() iter.Seq2[Id, Row2[A, B]] {
+	return func(yield func(Id, Row2[A, B]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+
+				if !yield(id, Row2[A, B]{retA, retB}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+
+			retA = nil
+			retB = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row2[A, B]{retA, retB}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row2[A, B]{retA, retB}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View2[A, B]) MapSlices(lambda func(id []Id, a []A, b []B)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -379,25 +1151,30 @@ func (v *View2[A, B]) MapSlices(lambda func(id []Id, a []A, b []B)) {
 	sliceListB := make([][]B, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -405,6 +1182,8 @@ func (v *View2[A, B]) MapSlices(lambda func(id []Id, a []A, b []B)) {
 			sliceListA[idx], sliceListB[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -416,39 +1195,38 @@ type View3[A, B, C any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
+	compA CompId
+	compB CompId
+	compC CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View3
+type Row3[A, B, C any] struct {
+	A *A
+	B *B
+	C *C
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query3[A, B, C any](world *World, filters ...Filter) *View3[A, B, C] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
 	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
 
 	v := &View3[A, B, C]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -456,71 +1234,151 @@ func Query3[A, B, C any](world *World, filters ...Filter) *View3[A, B, C] {
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View3[A, B, C]) Read(id Id) (*A, *B, *C) {
 	if id == InvalidEntity {
 		return nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
 	var retC *C
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
 
 	return retA, retB, retC
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -540,20 +1398,74 @@ func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 		retA = nil
 		retB = nil
 		retC = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC)
 		}
 
@@ -586,6 +1498,7 @@ func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -610,9 +1523,424 @@ func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View3[A, B, C]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row3 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View3[A, B, C]) All() iter.Seq2[Id, Row3[A, B, C]] {
+	return func(yield func(Id, Row3[A, B, C]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+
+				if !yield(id, Row3[A, B, C]{retA, retB, retC}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row3[A, B, C]{retA, retB, retC}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row3[A, B, C]{retA, retB, retC}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View3[A, B, C]) MapSlices(lambda func(id []Id, a []A, b []B, c []C)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -621,30 +1949,39 @@ func (v *View3[A, B, C]) MapSlices(lambda func(id []Id, a []A, b []B, c []C)) {
 	sliceListC := make([][]C, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -652,6 +1989,8 @@ func (v *View3[A, B, C]) MapSlices(lambda func(id []Id, a []A, b []B, c []C)) {
 			sliceListA[idx], sliceListB[idx], sliceListC[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -663,44 +2002,42 @@ type View4[A, B, C, D any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
 }
 
-// Creates a View for the specified world with the specified component filters.
-func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D] {
-
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View4
+type Row4[A, B, C, D any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}
 
-	comps := []componentId{
+// Creates a View for the specified world with the specified component filters.
+func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D] {
+	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
 	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
 
 	v := &View4[A, B, C, D]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -708,81 +2045,180 @@ func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D]
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View4[A, B, C, D]) Read(id Id) (*A, *B, *C, *D) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
 	var retC *C
 	var retD *D
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
 
 	return retA, retB, retC, retD
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC, retD)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -807,23 +2243,88 @@ func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 		retB = nil
 		retC = nil
 		retD = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD)
 		}
 
@@ -856,6 +2357,7 @@ func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -880,9 +2382,505 @@ func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View4[A, B, C, D]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row4 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View4[A, B, C, D]) All() iter.Seq2[Id, Row4[A, B, C, D]] {
+	return func(yield func(Id, Row4[A, B, C, D]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+
+				if !yield(id, Row4[A, B, C, D]{retA, retB, retC, retD}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row4[A, B, C, D]{retA, retB, retC, retD}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row4[A, B, C, D]{retA, retB, retC, retD}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View4[A, B, C, D]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -892,35 +2890,48 @@ func (v *View4[A, B, C, D]) MapSlices(lambda func(id []Id, a []A, b []B, c []C,
 	sliceListD := make([][]D, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -928,6 +2939,8 @@ func (v *View4[A, B, C, D]) MapSlices(lambda func(id []Id, a []A, b []B, c []C,
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -939,49 +2952,46 @@ type View5[A, B, C, D, E any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View5
+type Row5[A, B, C, D, E any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query5[A, B, C, D, E any](world *World, filters ...Filter) *View5[A, B, C, D, E] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
 	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
 
 	v := &View5[A, B, C, D, E]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -989,23 +2999,18 @@ func Query5[A, B, C, D, E any](world *World, filters ...Filter) *View5[A, B, C,
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View5[A, B, C, D, E]) Read(id Id) (*A, *B, *C, *D, *E) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -1013,67 +3018,190 @@ func (v *View5[A, B, C, D, E]) Read(id Id) (*A, *B, *C, *D, *E) {
 	var retD *D
 	var retE *E
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
 
 	return retA, retB, retC, retD, retE
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC, retD, retE)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -1103,26 +3231,102 @@ func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D,
 		retC = nil
 		retD = nil
 		retE = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE)
 		}
 
@@ -1155,6 +3359,7 @@ func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D,
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -1179,9 +3384,586 @@ func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D,
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View5[A, B, C, D, E]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD, retE)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row5 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View5[A, B, C, D, E]) All() iter.Seq2[Id, Row5[A, B, C, D, E]] {
+	return func(yield func(Id, Row5[A, B, C, D, E]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+
+				if !yield(id, Row5[A, B, C, D, E]{retA, retB, retC, retD, retE}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row5[A, B, C, D, E]{retA, retB, retC, retD, retE}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row5[A, B, C, D, E]{retA, retB, retC, retD, retE}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View5[A, B, C, D, E]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -1192,40 +3974,57 @@ func (v *View5[A, B, C, D, E]) MapSlices(lambda func(id []Id, a []A, b []B, c []
 	sliceListE := make([][]E, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -1233,6 +4032,8 @@ func (v *View5[A, B, C, D, E]) MapSlices(lambda func(id []Id, a []A, b []B, c []
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -1244,54 +4045,50 @@ type View6[A, B, C, D, E, F any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View6
+type Row6[A, B, C, D, E, F any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query6[A, B, C, D, E, F any](world *World, filters ...Filter) *View6[A, B, C, D, E, F] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
 	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
 
 	v := &View6[A, B, C, D, E, F]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -1299,23 +4096,18 @@ func Query6[A, B, C, D, E, F any](world *World, filters ...Filter) *View6[A, B,
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View6[A, B, C, D, E, F]) Read(id Id) (*A, *B, *C, *D, *E, *F) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -1324,90 +4116,232 @@ func (v *View6[A, B, C, D, E, F]) Read(id Id) (*A, *B, *C, *D, *E, *F) {
 	var retE *E
 	var retF *F
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
 
-	for _, archId := range v.filter.archIds {
-
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
-		ids := lookup.id
-
-		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		compA = nil
-		if sliceA != nil {
-			compA = sliceA.comp
-		}
-		compB = nil
-		if sliceB != nil {
-			compB = sliceB.comp
-		}
-		compC = nil
-		if sliceC != nil {
-			compC = sliceC.comp
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+
+			lambda(id, retA, retB, retC, retD, retE, retF)
+		}
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+
+		ids := arch.lookup.id
+
+		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
 		}
 		compD = nil
 		if sliceD != nil {
@@ -1428,29 +4362,116 @@ func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *
 		retD = nil
 		retE = nil
 		retF = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF)
 		}
 
@@ -1483,6 +4504,7 @@ func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -1507,9 +4529,667 @@ func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View6[A, B, C, D, E, F]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row6 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View6[A, B, C, D, E, F]) All() iter.Seq2[Id, Row6[A, B, C, D, E, F]] {
+	return func(yield func(Id, Row6[A, B, C, D, E, F]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+
+				if !yield(id, Row6[A, B, C, D, E, F]{retA, retB, retC, retD, retE, retF}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row6[A, B, C, D, E, F]{retA, retB, retC, retD, retE, retF}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row6[A, B, C, D, E, F]{retA, retB, retC, retD, retE, retF}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -1521,45 +5201,66 @@ func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c
 	sliceListF := make([][]F, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -1567,6 +5268,8 @@ func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -1578,59 +5281,54 @@ type View7[A, B, C, D, E, F, G any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
-	storageG componentSliceStorage[G]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+	compG CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View7
+type Row7[A, B, C, D, E, F, G any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query7[A, B, C, D, E, F, G any](world *World, filters ...Filter) *View7[A, B, C, D, E, F, G] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
+		nameTyped[G](),
+	}
 
 	v := &View7[A, B, C, D, E, F, G]{
-		world:  world,
-		filter: filterList,
+		world: world,
 
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
+		compG: comps[6],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -1638,23 +5336,18 @@ func Query7[A, B, C, D, E, F, G any](world *World, filters ...Filter) *View7[A,
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View7[A, B, C, D, E, F, G]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -1664,85 +5357,246 @@ func (v *View7[A, B, C, D, E, F, G]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G) {
 	var retF *F
 	var retG *G
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
-	sliceG, ok := v.storageG.slice[archId]
-	if ok {
+	sliceG := getColumn[G](arch, v.compG)
+	if sliceG != nil {
 		retG = &sliceG.comp[index]
+	} else if sparseG := getSparse[G](v.world.engine, v.compG); sparseG != nil {
+		retG = sparseG.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF, retG
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
 
 	var sliceG *componentSlice[G]
 	var compG []G
 	var retG *G
+	var ticksG []componentTicks
+	sparseG := getSparse[G](v.world.engine, v.compG)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
+				sliceG = getColumn[G](arch, v.compG)
+				ticksG = arch.columnTicks(v.compG)
+				compG = nil
+				if sliceG != nil {
+					compG = sliceG.comp
+				}
+				retG = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-		sliceG, _ = v.storageG.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+			if compG != nil {
+				retG = &compG[idx]
+				if ticksG != nil {
+					ticksG[idx].changed = tick
+				}
+			} else if sparseG != nil {
+				retG = sparseG.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC, retD, retE, retF, retG)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+		sliceG = getColumn[G](arch, v.compG)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -1782,32 +5636,130 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 		retE = nil
 		retF = nil
 		retG = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			ticksG = arch.columnTicks(v.compG)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
 			if compG != nil {
 				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
 		}
 
@@ -1840,6 +5792,7 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -1864,141 +5817,901 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 	// }
 }
 
-// Deprecated: This API is a tentative alternative way to map
-func (v *View7[A, B, C, D, E, F, G]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G)) {
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	sparseG := getSparse[G](v.world.engine, v.compG)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+			var sliceG *componentSlice[G]
+			var compG []G
+			var retG *G
+			var ticksG []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF, retG)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
 
-	id := make([][]Id, 0)
-
-	sliceListA := make([][]A, 0)
-	sliceListB := make([][]B, 0)
-	sliceListC := make([][]C, 0)
-	sliceListD := make([][]D, 0)
-	sliceListE := make([][]E, 0)
-	sliceListF := make([][]F, 0)
-	sliceListG := make([][]G, 0)
-
-	for _, archId := range v.filter.archIds {
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
-			continue
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
-			continue
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
-			continue
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
-			continue
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
-			continue
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
-			continue
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
 		}
-		sliceG, ok := v.storageG.slice[archId]
-		if !ok {
-			continue
+
+		var compG []G
+		var retG *G
+		if sliceG := getColumn[G](arch, v.compG); sliceG != nil {
+			compG = sliceG.comp
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			ticksG := arch.columnTicks(v.compG)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+			}
+			return
 		}
 
-		id = append(id, lookup.id)
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-	}
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
+			}
 
-	for idx := range id {
-		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx],
-		)
-	}
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
 }
 
-// --------------------------------------------------------------------------------
-// - View 8
-// --------------------------------------------------------------------------------
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row7 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View7[A, B, C, D, E, F, G]) All() iter.Seq2[Id, Row7[A, B, C, D, E, F, G]] {
+	return func(yield func(Id, Row7[A, B, C, D, E, F, G]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		var sliceG *componentSlice[G]
+		var compG []G
+		var retG *G
+		var ticksG []componentTicks
+		sparseG := getSparse[G](v.world.engine, v.compG)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+
+				if !yield(id, Row7[A, B, C, D, E, F, G]{retA, retB, retC, retD, retE, retF, retG}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
 
-// Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View8[A, B, C, D, E, F, G, H any] struct {
-	world  *World
-	filter filterList
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
-	storageG componentSliceStorage[G]
-	storageH componentSliceStorage[H]
-}
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+			sliceG = getColumn[G](arch, v.compG)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				ticksG = arch.columnTicks(v.compG)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+					if compG != nil {
+						retG = &compG[idx]
+						if ticksG != nil {
+							ticksG[idx].changed = tick
+						}
+					} else if sparseG != nil {
+						retG = sparseG.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row7[A, B, C, D, E, F, G]{retA, retB, retC, retD, retE, retF, retG}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row7[A, B, C, D, E, F, G]{retA, retB, retC, retD, retE, retF, retG}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
+// Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
+func (v *View7[A, B, C, D, E, F, G]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	id := make([][]Id, 0)
+
+	sliceListA := make([][]A, 0)
+	sliceListB := make([][]B, 0)
+	sliceListC := make([][]C, 0)
+	sliceListD := make([][]D, 0)
+	sliceListE := make([][]E, 0)
+	sliceListF := make([][]F, 0)
+	sliceListG := make([][]G, 0)
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
+			continue
+		}
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
+			continue
+		}
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
+			continue
+		}
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
+			continue
+		}
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
+			continue
+		}
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
+			continue
+		}
+		sliceG := getColumn[G](arch, v.compG)
+		if sliceG == nil {
+			continue
+		}
+
+		id = append(id, arch.lookup.id)
+
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
+		sliceListG = append(sliceListG, sliceG.comp[:len(arch.lookup.id)])
+
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
+		ticksG := arch.columnTicks(v.compG)
+		for i := range ticksG {
+			ticksG[i].changed = tick
+		}
+	}
+
+	for idx := range id {
+		lambda(id[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx],
+		)
+	}
+
+	v.filter.endRun(v.world, tick)
+}
+
+// --------------------------------------------------------------------------------
+// - View 8
+// --------------------------------------------------------------------------------
+
+// Represents a view of data in a specific world. Provides access to the components specified in the generic block
+type View8[A, B, C, D, E, F, G, H any] struct {
+	world  *World
+	filter filterList
+
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+	compG CompId
+	compH CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View8
+type Row8[A, B, C, D, E, F, G, H any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+}
 
 // Creates a View for the specified world with the specified component filters.
 func Query8[A, B, C, D, E, F, G, H any](world *World, filters ...Filter) *View8[A, B, C, D, E, F, G, H] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
+		nameTyped[G](),
+		nameTyped[H](),
+	}
 
 	v := &View8[A, B, C, D, E, F, G, H]{
-		world:  world,
-		filter: filterList,
-
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
-		storageH: storageH,
+		world: world,
+
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
+		compG: comps[6],
+		compH: comps[7],
 	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -2006,23 +6719,18 @@ func Query8[A, B, C, D, E, F, G, H any](world *World, filters ...Filter) *View8[
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View8[A, B, C, D, E, F, G, H]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -2033,94 +6741,274 @@ func (v *View8[A, B, C, D, E, F, G, H]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G,
 	var retG *G
 	var retH *H
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
-	sliceG, ok := v.storageG.slice[archId]
-	if ok {
+	sliceG := getColumn[G](arch, v.compG)
+	if sliceG != nil {
 		retG = &sliceG.comp[index]
+	} else if sparseG := getSparse[G](v.world.engine, v.compG); sparseG != nil {
+		retG = sparseG.get(id)
 	}
-	sliceH, ok := v.storageH.slice[archId]
-	if ok {
+	sliceH := getColumn[H](arch, v.compH)
+	if sliceH != nil {
 		retH = &sliceH.comp[index]
+	} else if sparseH := getSparse[H](v.world.engine, v.compH); sparseH != nil {
+		retH = sparseH.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF, retG, retH
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
 
 	var sliceG *componentSlice[G]
 	var compG []G
 	var retG *G
+	var ticksG []componentTicks
+	sparseG := getSparse[G](v.world.engine, v.compG)
 
 	var sliceH *componentSlice[H]
 	var compH []H
 	var retH *H
+	var ticksH []componentTicks
+	sparseH := getSparse[H](v.world.engine, v.compH)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
+				sliceG = getColumn[G](arch, v.compG)
+				ticksG = arch.columnTicks(v.compG)
+				compG = nil
+				if sliceG != nil {
+					compG = sliceG.comp
+				}
+				retG = nil
+				sliceH = getColumn[H](arch, v.compH)
+				ticksH = arch.columnTicks(v.compH)
+				compH = nil
+				if sliceH != nil {
+					compH = sliceH.comp
+				}
+				retH = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-		sliceG, _ = v.storageG.slice[archId]
-		sliceH, _ = v.storageH.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+			if compG != nil {
+				retG = &compG[idx]
+				if ticksG != nil {
+					ticksG[idx].changed = tick
+				}
+			} else if sparseG != nil {
+				retG = sparseG.getChanged(id, tick)
+			}
+			if compH != nil {
+				retH = &compH[idx]
+				if ticksH != nil {
+					ticksH[idx].changed = tick
+				}
+			} else if sparseH != nil {
+				retH = sparseH.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC, retD, retE, retF, retG, retH)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+		sliceG = getColumn[G](arch, v.compG)
+		sliceH = getColumn[H](arch, v.compH)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -2165,35 +7053,144 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 		retF = nil
 		retG = nil
 		retH = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			ticksG = arch.columnTicks(v.compG)
+			ticksH = arch.columnTicks(v.compH)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
 			if compG != nil {
 				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
 			}
 			if compH != nil {
 				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
 		}
 
@@ -2226,6 +7223,7 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -2250,9 +7248,829 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	sparseG := getSparse[G](v.world.engine, v.compG)
+	sparseH := getSparse[H](v.world.engine, v.compH)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+			var sliceG *componentSlice[G]
+			var compG []G
+			var retG *G
+			var ticksG []componentTicks
+			var sliceH *componentSlice[H]
+			var compH []H
+			var retH *H
+			var ticksH []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF, retG, retH)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		var compG []G
+		var retG *G
+		if sliceG := getColumn[G](arch, v.compG); sliceG != nil {
+			compG = sliceG.comp
+		}
+
+		var compH []H
+		var retH *H
+		if sliceH := getColumn[H](arch, v.compH); sliceH != nil {
+			compH = sliceH.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			ticksG := arch.columnTicks(v.compG)
+			ticksH := arch.columnTicks(v.compH)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row8 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View8[A, B, C, D, E, F, G, H]) All() iter.Seq2[Id, Row8[A, B, C, D, E, F, G, H]] {
+	return func(yield func(Id, Row8[A, B, C, D, E, F, G, H]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		var sliceG *componentSlice[G]
+		var compG []G
+		var retG *G
+		var ticksG []componentTicks
+		sparseG := getSparse[G](v.world.engine, v.compG)
+
+		var sliceH *componentSlice[H]
+		var compH []H
+		var retH *H
+		var ticksH []componentTicks
+		sparseH := getSparse[H](v.world.engine, v.compH)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+
+				if !yield(id, Row8[A, B, C, D, E, F, G, H]{retA, retB, retC, retD, retE, retF, retG, retH}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+			sliceG = getColumn[G](arch, v.compG)
+			sliceH = getColumn[H](arch, v.compH)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				ticksG = arch.columnTicks(v.compG)
+				ticksH = arch.columnTicks(v.compH)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+					if compG != nil {
+						retG = &compG[idx]
+						if ticksG != nil {
+							ticksG[idx].changed = tick
+						}
+					} else if sparseG != nil {
+						retG = sparseG.getChanged(ids[idx], tick)
+					}
+					if compH != nil {
+						retH = &compH[idx]
+						if ticksH != nil {
+							ticksH[idx].changed = tick
+						}
+					} else if sparseH != nil {
+						retH = sparseH.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row8[A, B, C, D, E, F, G, H]{retA, retB, retC, retD, retE, retF, retG, retH}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row8[A, B, C, D, E, F, G, H]{retA, retB, retC, retD, retE, retF, retG, retH}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -2266,55 +8084,84 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b
 	sliceListH := make([][]H, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
 			continue
 		}
-		sliceG, ok := v.storageG.slice[archId]
-		if !ok {
+		sliceG := getColumn[G](arch, v.compG)
+		if sliceG == nil {
 			continue
 		}
-		sliceH, ok := v.storageH.slice[archId]
-		if !ok {
+		sliceH := getColumn[H](arch, v.compH)
+		if sliceH == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
+		sliceListG = append(sliceListG, sliceG.comp[:len(arch.lookup.id)])
+		sliceListH = append(sliceListH, sliceH.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
+		ticksG := arch.columnTicks(v.compG)
+		for i := range ticksG {
+			ticksG[i].changed = tick
+		}
+		ticksH := arch.columnTicks(v.compH)
+		for i := range ticksH {
+			ticksH[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -2322,6 +8169,8 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -2333,69 +8182,62 @@ type View9[A, B, C, D, E, F, G, H, I any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
-	storageG componentSliceStorage[G]
-	storageH componentSliceStorage[H]
-	storageI componentSliceStorage[I]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+	compG CompId
+	compH CompId
+	compI CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View9
+type Row9[A, B, C, D, E, F, G, H, I any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query9[A, B, C, D, E, F, G, H, I any](world *World, filters ...Filter) *View9[A, B, C, D, E, F, G, H, I] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-	storageI := getStorage[I](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
+		nameTyped[G](),
+		nameTyped[H](),
+		nameTyped[I](),
+	}
 
 	v := &View9[A, B, C, D, E, F, G, H, I]{
-		world:  world,
-		filter: filterList,
-
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
-		storageH: storageH,
-		storageI: storageI,
-	}
+		world: world,
+
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
+		compG: comps[6],
+		compH: comps[7],
+		compI: comps[8],
+	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -2403,23 +8245,18 @@ func Query9[A, B, C, D, E, F, G, H, I any](world *World, filters ...Filter) *Vie
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View9[A, B, C, D, E, F, G, H, I]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -2431,116 +8268,315 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) Read(id Id) (*A, *B, *C, *D, *E, *F,
 	var retH *H
 	var retI *I
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
-	sliceG, ok := v.storageG.slice[archId]
-	if ok {
+	sliceG := getColumn[G](arch, v.compG)
+	if sliceG != nil {
 		retG = &sliceG.comp[index]
+	} else if sparseG := getSparse[G](v.world.engine, v.compG); sparseG != nil {
+		retG = sparseG.get(id)
 	}
-	sliceH, ok := v.storageH.slice[archId]
-	if ok {
+	sliceH := getColumn[H](arch, v.compH)
+	if sliceH != nil {
 		retH = &sliceH.comp[index]
+	} else if sparseH := getSparse[H](v.world.engine, v.compH); sparseH != nil {
+		retH = sparseH.get(id)
 	}
-	sliceI, ok := v.storageI.slice[archId]
-	if ok {
+	sliceI := getColumn[I](arch, v.compI)
+	if sliceI != nil {
 		retI = &sliceI.comp[index]
+	} else if sparseI := getSparse[I](v.world.engine, v.compI); sparseI != nil {
+		retI = sparseI.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF, retG, retH, retI
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
 
 	var sliceG *componentSlice[G]
 	var compG []G
 	var retG *G
+	var ticksG []componentTicks
+	sparseG := getSparse[G](v.world.engine, v.compG)
 
 	var sliceH *componentSlice[H]
 	var compH []H
 	var retH *H
+	var ticksH []componentTicks
+	sparseH := getSparse[H](v.world.engine, v.compH)
 
 	var sliceI *componentSlice[I]
 	var compI []I
 	var retI *I
+	var ticksI []componentTicks
+	sparseI := getSparse[I](v.world.engine, v.compI)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
+				sliceG = getColumn[G](arch, v.compG)
+				ticksG = arch.columnTicks(v.compG)
+				compG = nil
+				if sliceG != nil {
+					compG = sliceG.comp
+				}
+				retG = nil
+				sliceH = getColumn[H](arch, v.compH)
+				ticksH = arch.columnTicks(v.compH)
+				compH = nil
+				if sliceH != nil {
+					compH = sliceH.comp
+				}
+				retH = nil
+				sliceI = getColumn[I](arch, v.compI)
+				ticksI = arch.columnTicks(v.compI)
+				compI = nil
+				if sliceI != nil {
+					compI = sliceI.comp
+				}
+				retI = nil
 
-	for _, archId := range v.filter.archIds {
-
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-		sliceG, _ = v.storageG.slice[archId]
-		sliceH, _ = v.storageH.slice[archId]
-		sliceI, _ = v.storageI.slice[archId]
-
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
-		ids := lookup.id
-
-		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		compA = nil
-		if sliceA != nil {
-			compA = sliceA.comp
-		}
-		compB = nil
-		if sliceB != nil {
-			compB = sliceB.comp
-		}
-		compC = nil
-		if sliceC != nil {
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+			if compG != nil {
+				retG = &compG[idx]
+				if ticksG != nil {
+					ticksG[idx].changed = tick
+				}
+			} else if sparseG != nil {
+				retG = sparseG.getChanged(id, tick)
+			}
+			if compH != nil {
+				retH = &compH[idx]
+				if ticksH != nil {
+					ticksH[idx].changed = tick
+				}
+			} else if sparseH != nil {
+				retH = sparseH.getChanged(id, tick)
+			}
+			if compI != nil {
+				retI = &compI[idx]
+				if ticksI != nil {
+					ticksI[idx].changed = tick
+				}
+			} else if sparseI != nil {
+				retI = sparseI.getChanged(id, tick)
+			}
+
+			lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI)
+		}
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+		sliceG = getColumn[G](arch, v.compG)
+		sliceH = getColumn[H](arch, v.compH)
+		sliceI = getColumn[I](arch, v.compI)
+
+		ids := arch.lookup.id
+
+		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
 			compC = sliceC.comp
 		}
 		compD = nil
@@ -2577,38 +8613,158 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 		retG = nil
 		retH = nil
 		retI = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			ticksG = arch.columnTicks(v.compG)
+			ticksH = arch.columnTicks(v.compH)
+			ticksI = arch.columnTicks(v.compI)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
 			if compG != nil {
 				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
 			}
 			if compH != nil {
 				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
 			}
 			if compI != nil {
 				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
 		}
 
@@ -2641,6 +8797,7 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -2665,9 +8822,910 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	sparseG := getSparse[G](v.world.engine, v.compG)
+	sparseH := getSparse[H](v.world.engine, v.compH)
+	sparseI := getSparse[I](v.world.engine, v.compI)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+			var sliceG *componentSlice[G]
+			var compG []G
+			var retG *G
+			var ticksG []componentTicks
+			var sliceH *componentSlice[H]
+			var compH []H
+			var retH *H
+			var ticksH []componentTicks
+			var sliceI *componentSlice[I]
+			var compI []I
+			var retI *I
+			var ticksI []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		var compG []G
+		var retG *G
+		if sliceG := getColumn[G](arch, v.compG); sliceG != nil {
+			compG = sliceG.comp
+		}
+
+		var compH []H
+		var retH *H
+		if sliceH := getColumn[H](arch, v.compH); sliceH != nil {
+			compH = sliceH.comp
+		}
+
+		var compI []I
+		var retI *I
+		if sliceI := getColumn[I](arch, v.compI); sliceI != nil {
+			compI = sliceI.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			ticksG := arch.columnTicks(v.compG)
+			ticksH := arch.columnTicks(v.compH)
+			ticksI := arch.columnTicks(v.compI)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row9 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View9[A, B, C, D, E, F, G, H, I]) All() iter.Seq2[Id, Row9[A, B, C, D, E, F, G, H, I]] {
+	return func(yield func(Id, Row9[A, B, C, D, E, F, G, H, I]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		var sliceG *componentSlice[G]
+		var compG []G
+		var retG *G
+		var ticksG []componentTicks
+		sparseG := getSparse[G](v.world.engine, v.compG)
+
+		var sliceH *componentSlice[H]
+		var compH []H
+		var retH *H
+		var ticksH []componentTicks
+		sparseH := getSparse[H](v.world.engine, v.compH)
+
+		var sliceI *componentSlice[I]
+		var compI []I
+		var retI *I
+		var ticksI []componentTicks
+		sparseI := getSparse[I](v.world.engine, v.compI)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+
+				if !yield(id, Row9[A, B, C, D, E, F, G, H, I]{retA, retB, retC, retD, retE, retF, retG, retH, retI}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+			sliceG = getColumn[G](arch, v.compG)
+			sliceH = getColumn[H](arch, v.compH)
+			sliceI = getColumn[I](arch, v.compI)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				ticksG = arch.columnTicks(v.compG)
+				ticksH = arch.columnTicks(v.compH)
+				ticksI = arch.columnTicks(v.compI)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+					if compG != nil {
+						retG = &compG[idx]
+						if ticksG != nil {
+							ticksG[idx].changed = tick
+						}
+					} else if sparseG != nil {
+						retG = sparseG.getChanged(ids[idx], tick)
+					}
+					if compH != nil {
+						retH = &compH[idx]
+						if ticksH != nil {
+							ticksH[idx].changed = tick
+						}
+					} else if sparseH != nil {
+						retH = sparseH.getChanged(ids[idx], tick)
+					}
+					if compI != nil {
+						retI = &compI[idx]
+						if ticksI != nil {
+							ticksI[idx].changed = tick
+						}
+					} else if sparseI != nil {
+						retI = sparseI.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row9[A, B, C, D, E, F, G, H, I]{retA, retB, retC, retD, retE, retF, retG, retH, retI}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row9[A, B, C, D, E, F, G, H, I]{retA, retB, retC, retD, retE, retF, retG, retH, retI}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -2682,60 +9740,93 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A,
 	sliceListI := make([][]I, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
 			continue
 		}
-		sliceG, ok := v.storageG.slice[archId]
-		if !ok {
+		sliceG := getColumn[G](arch, v.compG)
+		if sliceG == nil {
 			continue
 		}
-		sliceH, ok := v.storageH.slice[archId]
-		if !ok {
+		sliceH := getColumn[H](arch, v.compH)
+		if sliceH == nil {
 			continue
 		}
-		sliceI, ok := v.storageI.slice[archId]
-		if !ok {
+		sliceI := getColumn[I](arch, v.compI)
+		if sliceI == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
+		sliceListG = append(sliceListG, sliceG.comp[:len(arch.lookup.id)])
+		sliceListH = append(sliceListH, sliceH.comp[:len(arch.lookup.id)])
+		sliceListI = append(sliceListI, sliceI.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
-		sliceListI = append(sliceListI, sliceI.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
+		ticksG := arch.columnTicks(v.compG)
+		for i := range ticksG {
+			ticksG[i].changed = tick
+		}
+		ticksH := arch.columnTicks(v.compH)
+		for i := range ticksH {
+			ticksH[i].changed = tick
+		}
+		ticksI := arch.columnTicks(v.compI)
+		for i := range ticksI {
+			ticksI[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -2743,6 +9834,8 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A,
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -2754,74 +9847,66 @@ type View10[A, B, C, D, E, F, G, H, I, J any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
-	storageG componentSliceStorage[G]
-	storageH componentSliceStorage[H]
-	storageI componentSliceStorage[I]
-	storageJ componentSliceStorage[J]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+	compG CompId
+	compH CompId
+	compI CompId
+	compJ CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View10
+type Row10[A, B, C, D, E, F, G, H, I, J any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+	J *J
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query10[A, B, C, D, E, F, G, H, I, J any](world *World, filters ...Filter) *View10[A, B, C, D, E, F, G, H, I, J] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-	storageI := getStorage[I](world.engine)
-	storageJ := getStorage[J](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-	var JJ J
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-		name(JJ),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
+		nameTyped[G](),
+		nameTyped[H](),
+		nameTyped[I](),
+		nameTyped[J](),
+	}
 
 	v := &View10[A, B, C, D, E, F, G, H, I, J]{
-		world:  world,
-		filter: filterList,
-
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
-		storageH: storageH,
-		storageI: storageI,
-		storageJ: storageJ,
-	}
+		world: world,
+
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
+		compG: comps[6],
+		compH: comps[7],
+		compI: comps[8],
+		compJ: comps[9],
+	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -2829,23 +9914,18 @@ func Query10[A, B, C, D, E, F, G, H, I, J any](world *World, filters ...Filter)
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View10[A, B, C, D, E, F, G, H, I, J]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -2858,112 +9938,330 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) Read(id Id) (*A, *B, *C, *D, *E,
 	var retI *I
 	var retJ *J
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
-	sliceG, ok := v.storageG.slice[archId]
-	if ok {
+	sliceG := getColumn[G](arch, v.compG)
+	if sliceG != nil {
 		retG = &sliceG.comp[index]
+	} else if sparseG := getSparse[G](v.world.engine, v.compG); sparseG != nil {
+		retG = sparseG.get(id)
 	}
-	sliceH, ok := v.storageH.slice[archId]
-	if ok {
+	sliceH := getColumn[H](arch, v.compH)
+	if sliceH != nil {
 		retH = &sliceH.comp[index]
+	} else if sparseH := getSparse[H](v.world.engine, v.compH); sparseH != nil {
+		retH = sparseH.get(id)
 	}
-	sliceI, ok := v.storageI.slice[archId]
-	if ok {
+	sliceI := getColumn[I](arch, v.compI)
+	if sliceI != nil {
 		retI = &sliceI.comp[index]
+	} else if sparseI := getSparse[I](v.world.engine, v.compI); sparseI != nil {
+		retI = sparseI.get(id)
 	}
-	sliceJ, ok := v.storageJ.slice[archId]
-	if ok {
+	sliceJ := getColumn[J](arch, v.compJ)
+	if sliceJ != nil {
 		retJ = &sliceJ.comp[index]
+	} else if sparseJ := getSparse[J](v.world.engine, v.compJ); sparseJ != nil {
+		retJ = sparseJ.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
 
 	var sliceG *componentSlice[G]
 	var compG []G
 	var retG *G
+	var ticksG []componentTicks
+	sparseG := getSparse[G](v.world.engine, v.compG)
 
 	var sliceH *componentSlice[H]
 	var compH []H
 	var retH *H
+	var ticksH []componentTicks
+	sparseH := getSparse[H](v.world.engine, v.compH)
 
 	var sliceI *componentSlice[I]
 	var compI []I
 	var retI *I
+	var ticksI []componentTicks
+	sparseI := getSparse[I](v.world.engine, v.compI)
 
 	var sliceJ *componentSlice[J]
 	var compJ []J
 	var retJ *J
+	var ticksJ []componentTicks
+	sparseJ := getSparse[J](v.world.engine, v.compJ)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
+				sliceG = getColumn[G](arch, v.compG)
+				ticksG = arch.columnTicks(v.compG)
+				compG = nil
+				if sliceG != nil {
+					compG = sliceG.comp
+				}
+				retG = nil
+				sliceH = getColumn[H](arch, v.compH)
+				ticksH = arch.columnTicks(v.compH)
+				compH = nil
+				if sliceH != nil {
+					compH = sliceH.comp
+				}
+				retH = nil
+				sliceI = getColumn[I](arch, v.compI)
+				ticksI = arch.columnTicks(v.compI)
+				compI = nil
+				if sliceI != nil {
+					compI = sliceI.comp
+				}
+				retI = nil
+				sliceJ = getColumn[J](arch, v.compJ)
+				ticksJ = arch.columnTicks(v.compJ)
+				compJ = nil
+				if sliceJ != nil {
+					compJ = sliceJ.comp
+				}
+				retJ = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-		sliceG, _ = v.storageG.slice[archId]
-		sliceH, _ = v.storageH.slice[archId]
-		sliceI, _ = v.storageI.slice[archId]
-		sliceJ, _ = v.storageJ.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+			if compG != nil {
+				retG = &compG[idx]
+				if ticksG != nil {
+					ticksG[idx].changed = tick
+				}
+			} else if sparseG != nil {
+				retG = sparseG.getChanged(id, tick)
+			}
+			if compH != nil {
+				retH = &compH[idx]
+				if ticksH != nil {
+					ticksH[idx].changed = tick
+				}
+			} else if sparseH != nil {
+				retH = sparseH.getChanged(id, tick)
+			}
+			if compI != nil {
+				retI = &compI[idx]
+				if ticksI != nil {
+					ticksI[idx].changed = tick
+				}
+			} else if sparseI != nil {
+				retI = sparseI.getChanged(id, tick)
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+				if ticksJ != nil {
+					ticksJ[idx].changed = tick
+				}
+			} else if sparseJ != nil {
+				retJ = sparseJ.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+		sliceG = getColumn[G](arch, v.compG)
+		sliceH = getColumn[H](arch, v.compH)
+		sliceI = getColumn[I](arch, v.compI)
+		sliceJ = getColumn[J](arch, v.compJ)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -3018,41 +10316,172 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 		retH = nil
 		retI = nil
 		retJ = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			ticksG = arch.columnTicks(v.compG)
+			ticksH = arch.columnTicks(v.compH)
+			ticksI = arch.columnTicks(v.compI)
+			ticksJ = arch.columnTicks(v.compJ)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
 			if compG != nil {
 				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
 			}
 			if compH != nil {
 				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
 			}
 			if compI != nil {
 				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
 			}
 			if compJ != nil {
 				retJ = &compJ[idx]
+			} else if sparseJ != nil {
+				retJ = sparseJ.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
 		}
 
@@ -3085,6 +10514,7 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -3109,9 +10539,991 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	sparseG := getSparse[G](v.world.engine, v.compG)
+	sparseH := getSparse[H](v.world.engine, v.compH)
+	sparseI := getSparse[I](v.world.engine, v.compI)
+	sparseJ := getSparse[J](v.world.engine, v.compJ)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+			var sliceG *componentSlice[G]
+			var compG []G
+			var retG *G
+			var ticksG []componentTicks
+			var sliceH *componentSlice[H]
+			var compH []H
+			var retH *H
+			var ticksH []componentTicks
+			var sliceI *componentSlice[I]
+			var compI []I
+			var retI *I
+			var ticksI []componentTicks
+			var sliceJ *componentSlice[J]
+			var compJ []J
+			var retJ *J
+			var ticksJ []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+					sliceJ = getColumn[J](arch, v.compJ)
+					ticksJ = arch.columnTicks(v.compJ)
+					compJ = nil
+					if sliceJ != nil {
+						compJ = sliceJ.comp
+					}
+					retJ = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		var compG []G
+		var retG *G
+		if sliceG := getColumn[G](arch, v.compG); sliceG != nil {
+			compG = sliceG.comp
+		}
+
+		var compH []H
+		var retH *H
+		if sliceH := getColumn[H](arch, v.compH); sliceH != nil {
+			compH = sliceH.comp
+		}
+
+		var compI []I
+		var retI *I
+		if sliceI := getColumn[I](arch, v.compI); sliceI != nil {
+			compI = sliceI.comp
+		}
+
+		var compJ []J
+		var retJ *J
+		if sliceJ := getColumn[J](arch, v.compJ); sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			ticksG := arch.columnTicks(v.compG)
+			ticksH := arch.columnTicks(v.compH)
+			ticksI := arch.columnTicks(v.compI)
+			ticksJ := arch.columnTicks(v.compJ)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+			} else if sparseJ != nil {
+				retJ = sparseJ.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row10 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) All() iter.Seq2[Id, Row10[A, B, C, D, E, F, G, H, I, J]] {
+	return func(yield func(Id, Row10[A, B, C, D, E, F, G, H, I, J]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		var sliceG *componentSlice[G]
+		var compG []G
+		var retG *G
+		var ticksG []componentTicks
+		sparseG := getSparse[G](v.world.engine, v.compG)
+
+		var sliceH *componentSlice[H]
+		var compH []H
+		var retH *H
+		var ticksH []componentTicks
+		sparseH := getSparse[H](v.world.engine, v.compH)
+
+		var sliceI *componentSlice[I]
+		var compI []I
+		var retI *I
+		var ticksI []componentTicks
+		sparseI := getSparse[I](v.world.engine, v.compI)
+
+		var sliceJ *componentSlice[J]
+		var compJ []J
+		var retJ *J
+		var ticksJ []componentTicks
+		sparseJ := getSparse[J](v.world.engine, v.compJ)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+					sliceJ = getColumn[J](arch, v.compJ)
+					ticksJ = arch.columnTicks(v.compJ)
+					compJ = nil
+					if sliceJ != nil {
+						compJ = sliceJ.comp
+					}
+					retJ = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(id, tick)
+				}
+
+				if !yield(id, Row10[A, B, C, D, E, F, G, H, I, J]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+			sliceG = getColumn[G](arch, v.compG)
+			sliceH = getColumn[H](arch, v.compH)
+			sliceI = getColumn[I](arch, v.compI)
+			sliceJ = getColumn[J](arch, v.compJ)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				ticksG = arch.columnTicks(v.compG)
+				ticksH = arch.columnTicks(v.compH)
+				ticksI = arch.columnTicks(v.compI)
+				ticksJ = arch.columnTicks(v.compJ)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+					if compG != nil {
+						retG = &compG[idx]
+						if ticksG != nil {
+							ticksG[idx].changed = tick
+						}
+					} else if sparseG != nil {
+						retG = sparseG.getChanged(ids[idx], tick)
+					}
+					if compH != nil {
+						retH = &compH[idx]
+						if ticksH != nil {
+							ticksH[idx].changed = tick
+						}
+					} else if sparseH != nil {
+						retH = sparseH.getChanged(ids[idx], tick)
+					}
+					if compI != nil {
+						retI = &compI[idx]
+						if ticksI != nil {
+							ticksI[idx].changed = tick
+						}
+					} else if sparseI != nil {
+						retI = sparseI.getChanged(ids[idx], tick)
+					}
+					if compJ != nil {
+						retJ = &compJ[idx]
+						if ticksJ != nil {
+							ticksJ[idx].changed = tick
+						}
+					} else if sparseJ != nil {
+						retJ = sparseJ.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row10[A, B, C, D, E, F, G, H, I, J]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row10[A, B, C, D, E, F, G, H, I, J]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -3127,65 +11539,102 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapSlices(lambda func(id []Id, a
 	sliceListJ := make([][]J, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
 			continue
 		}
-		sliceG, ok := v.storageG.slice[archId]
-		if !ok {
+		sliceG := getColumn[G](arch, v.compG)
+		if sliceG == nil {
 			continue
 		}
-		sliceH, ok := v.storageH.slice[archId]
-		if !ok {
+		sliceH := getColumn[H](arch, v.compH)
+		if sliceH == nil {
 			continue
 		}
-		sliceI, ok := v.storageI.slice[archId]
-		if !ok {
+		sliceI := getColumn[I](arch, v.compI)
+		if sliceI == nil {
 			continue
 		}
-		sliceJ, ok := v.storageJ.slice[archId]
-		if !ok {
+		sliceJ := getColumn[J](arch, v.compJ)
+		if sliceJ == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
+		sliceListG = append(sliceListG, sliceG.comp[:len(arch.lookup.id)])
+		sliceListH = append(sliceListH, sliceH.comp[:len(arch.lookup.id)])
+		sliceListI = append(sliceListI, sliceI.comp[:len(arch.lookup.id)])
+		sliceListJ = append(sliceListJ, sliceJ.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
-		sliceListI = append(sliceListI, sliceI.comp)
-		sliceListJ = append(sliceListJ, sliceJ.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
+		ticksG := arch.columnTicks(v.compG)
+		for i := range ticksG {
+			ticksG[i].changed = tick
+		}
+		ticksH := arch.columnTicks(v.compH)
+		for i := range ticksH {
+			ticksH[i].changed = tick
+		}
+		ticksI := arch.columnTicks(v.compI)
+		for i := range ticksI {
+			ticksI[i].changed = tick
+		}
+		ticksJ := arch.columnTicks(v.compJ)
+		for i := range ticksJ {
+			ticksJ[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -3193,6 +11642,8 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapSlices(lambda func(id []Id, a
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -3204,79 +11655,70 @@ type View11[A, B, C, D, E, F, G, H, I, J, K any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
-	storageG componentSliceStorage[G]
-	storageH componentSliceStorage[H]
-	storageI componentSliceStorage[I]
-	storageJ componentSliceStorage[J]
-	storageK componentSliceStorage[K]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+	compG CompId
+	compH CompId
+	compI CompId
+	compJ CompId
+	compK CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View11
+type Row11[A, B, C, D, E, F, G, H, I, J, K any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+	J *J
+	K *K
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query11[A, B, C, D, E, F, G, H, I, J, K any](world *World, filters ...Filter) *View11[A, B, C, D, E, F, G, H, I, J, K] {
+	comps := []CompId{
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-	storageI := getStorage[I](world.engine)
-	storageJ := getStorage[J](world.engine)
-	storageK := getStorage[K](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-	var JJ J
-	var KK K
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-		name(JJ),
-		name(KK),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
+		nameTyped[G](),
+		nameTyped[H](),
+		nameTyped[I](),
+		nameTyped[J](),
+		nameTyped[K](),
+	}
 
 	v := &View11[A, B, C, D, E, F, G, H, I, J, K]{
-		world:  world,
-		filter: filterList,
-
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
-		storageH: storageH,
-		storageI: storageI,
-		storageJ: storageJ,
-		storageK: storageK,
-	}
+		world: world,
+
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
+		compG: comps[6],
+		compH: comps[7],
+		compI: comps[8],
+		compJ: comps[9],
+		compK: comps[10],
+	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -3284,23 +11726,18 @@ func Query11[A, B, C, D, E, F, G, H, I, J, K any](world *World, filters ...Filte
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -3314,121 +11751,358 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Read(id Id) (*A, *B, *C, *D, *
 	var retJ *J
 	var retK *K
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
-	sliceG, ok := v.storageG.slice[archId]
-	if ok {
+	sliceG := getColumn[G](arch, v.compG)
+	if sliceG != nil {
 		retG = &sliceG.comp[index]
+	} else if sparseG := getSparse[G](v.world.engine, v.compG); sparseG != nil {
+		retG = sparseG.get(id)
 	}
-	sliceH, ok := v.storageH.slice[archId]
-	if ok {
+	sliceH := getColumn[H](arch, v.compH)
+	if sliceH != nil {
 		retH = &sliceH.comp[index]
+	} else if sparseH := getSparse[H](v.world.engine, v.compH); sparseH != nil {
+		retH = sparseH.get(id)
 	}
-	sliceI, ok := v.storageI.slice[archId]
-	if ok {
+	sliceI := getColumn[I](arch, v.compI)
+	if sliceI != nil {
 		retI = &sliceI.comp[index]
+	} else if sparseI := getSparse[I](v.world.engine, v.compI); sparseI != nil {
+		retI = sparseI.get(id)
 	}
-	sliceJ, ok := v.storageJ.slice[archId]
-	if ok {
+	sliceJ := getColumn[J](arch, v.compJ)
+	if sliceJ != nil {
 		retJ = &sliceJ.comp[index]
+	} else if sparseJ := getSparse[J](v.world.engine, v.compJ); sparseJ != nil {
+		retJ = sparseJ.get(id)
 	}
-	sliceK, ok := v.storageK.slice[archId]
-	if ok {
+	sliceK := getColumn[K](arch, v.compK)
+	if sliceK != nil {
 		retK = &sliceK.comp[index]
+	} else if sparseK := getSparse[K](v.world.engine, v.compK); sparseK != nil {
+		retK = sparseK.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
 
 	var sliceG *componentSlice[G]
 	var compG []G
 	var retG *G
+	var ticksG []componentTicks
+	sparseG := getSparse[G](v.world.engine, v.compG)
 
 	var sliceH *componentSlice[H]
 	var compH []H
 	var retH *H
+	var ticksH []componentTicks
+	sparseH := getSparse[H](v.world.engine, v.compH)
 
 	var sliceI *componentSlice[I]
 	var compI []I
 	var retI *I
+	var ticksI []componentTicks
+	sparseI := getSparse[I](v.world.engine, v.compI)
 
 	var sliceJ *componentSlice[J]
 	var compJ []J
 	var retJ *J
+	var ticksJ []componentTicks
+	sparseJ := getSparse[J](v.world.engine, v.compJ)
 
 	var sliceK *componentSlice[K]
 	var compK []K
 	var retK *K
+	var ticksK []componentTicks
+	sparseK := getSparse[K](v.world.engine, v.compK)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) || v.world.engine.tracks(v.compK) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
+				sliceG = getColumn[G](arch, v.compG)
+				ticksG = arch.columnTicks(v.compG)
+				compG = nil
+				if sliceG != nil {
+					compG = sliceG.comp
+				}
+				retG = nil
+				sliceH = getColumn[H](arch, v.compH)
+				ticksH = arch.columnTicks(v.compH)
+				compH = nil
+				if sliceH != nil {
+					compH = sliceH.comp
+				}
+				retH = nil
+				sliceI = getColumn[I](arch, v.compI)
+				ticksI = arch.columnTicks(v.compI)
+				compI = nil
+				if sliceI != nil {
+					compI = sliceI.comp
+				}
+				retI = nil
+				sliceJ = getColumn[J](arch, v.compJ)
+				ticksJ = arch.columnTicks(v.compJ)
+				compJ = nil
+				if sliceJ != nil {
+					compJ = sliceJ.comp
+				}
+				retJ = nil
+				sliceK = getColumn[K](arch, v.compK)
+				ticksK = arch.columnTicks(v.compK)
+				compK = nil
+				if sliceK != nil {
+					compK = sliceK.comp
+				}
+				retK = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-		sliceG, _ = v.storageG.slice[archId]
-		sliceH, _ = v.storageH.slice[archId]
-		sliceI, _ = v.storageI.slice[archId]
-		sliceJ, _ = v.storageJ.slice[archId]
-		sliceK, _ = v.storageK.slice[archId]
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+			if compG != nil {
+				retG = &compG[idx]
+				if ticksG != nil {
+					ticksG[idx].changed = tick
+				}
+			} else if sparseG != nil {
+				retG = sparseG.getChanged(id, tick)
+			}
+			if compH != nil {
+				retH = &compH[idx]
+				if ticksH != nil {
+					ticksH[idx].changed = tick
+				}
+			} else if sparseH != nil {
+				retH = sparseH.getChanged(id, tick)
+			}
+			if compI != nil {
+				retI = &compI[idx]
+				if ticksI != nil {
+					ticksI[idx].changed = tick
+				}
+			} else if sparseI != nil {
+				retI = sparseI.getChanged(id, tick)
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+				if ticksJ != nil {
+					ticksJ[idx].changed = tick
+				}
+			} else if sparseJ != nil {
+				retJ = sparseJ.getChanged(id, tick)
+			}
+			if compK != nil {
+				retK = &compK[idx]
+				if ticksK != nil {
+					ticksK[idx].changed = tick
+				}
+			} else if sparseK != nil {
+				retK = sparseK.getChanged(id, tick)
+			}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
+			lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
 		}
-		ids := lookup.id
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+		sliceG = getColumn[G](arch, v.compG)
+		sliceH = getColumn[H](arch, v.compH)
+		sliceI = getColumn[I](arch, v.compI)
+		sliceJ = getColumn[J](arch, v.compJ)
+		sliceK = getColumn[K](arch, v.compK)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -3488,44 +12162,186 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A,
 		retI = nil
 		retJ = nil
 		retK = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			ticksG = arch.columnTicks(v.compG)
+			ticksH = arch.columnTicks(v.compH)
+			ticksI = arch.columnTicks(v.compI)
+			ticksJ = arch.columnTicks(v.compJ)
+			ticksK = arch.columnTicks(v.compK)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(ids[idx], tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
 			if compG != nil {
 				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
 			}
 			if compH != nil {
 				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
 			}
 			if compI != nil {
 				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
 			}
 			if compJ != nil {
 				retJ = &compJ[idx]
+			} else if sparseJ != nil {
+				retJ = sparseJ.get(ids[idx])
 			}
 			if compK != nil {
 				retK = &compK[idx]
+			} else if sparseK != nil {
+				retK = sparseK.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
 		}
 
@@ -3558,33 +12374,1097 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A,
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
+
+	// Original - doesn't handle optional
+	// for _, archId := range v.filter.archIds {
+	// 	aSlice, ok := v.storageA.slice[archId]
+	// 	if !ok { continue }
+	// 	bSlice, ok := v.storageB.slice[archId]
+	// 	if !ok { continue }
+
+	// 	lookup, ok := v.world.engine.lookup[archId]
+	// 	if !ok { panic("LookupList is missing!") }
+
+	// 	ids := lookup.id
+	// 	aComp := aSlice.comp
+	// 	bComp := bSlice.comp
+	// 	if len(ids) != len(aComp) || len(ids) != len(bComp) {
+	// 		panic("ERROR - Bounds don't match")
+	// 	}
+	// 	for i := range ids {
+	// 		if ids[i] == InvalidEntity { continue }
+	// 		lambda(ids[i], &aComp[i], &bComp[i])
+	// 	}
+	// }
+}
+
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	sparseG := getSparse[G](v.world.engine, v.compG)
+	sparseH := getSparse[H](v.world.engine, v.compH)
+	sparseI := getSparse[I](v.world.engine, v.compI)
+	sparseJ := getSparse[J](v.world.engine, v.compJ)
+	sparseK := getSparse[K](v.world.engine, v.compK)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) || v.world.engine.tracks(v.compK)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+			var sliceG *componentSlice[G]
+			var compG []G
+			var retG *G
+			var ticksG []componentTicks
+			var sliceH *componentSlice[H]
+			var compH []H
+			var retH *H
+			var ticksH []componentTicks
+			var sliceI *componentSlice[I]
+			var compI []I
+			var retI *I
+			var ticksI []componentTicks
+			var sliceJ *componentSlice[J]
+			var compJ []J
+			var retJ *J
+			var ticksJ []componentTicks
+			var sliceK *componentSlice[K]
+			var compK []K
+			var retK *K
+			var ticksK []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+					sliceJ = getColumn[J](arch, v.compJ)
+					ticksJ = arch.columnTicks(v.compJ)
+					compJ = nil
+					if sliceJ != nil {
+						compJ = sliceJ.comp
+					}
+					retJ = nil
+					sliceK = getColumn[K](arch, v.compK)
+					ticksK = arch.columnTicks(v.compK)
+					compK = nil
+					if sliceK != nil {
+						compK = sliceK.comp
+					}
+					retK = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(id, tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		var compG []G
+		var retG *G
+		if sliceG := getColumn[G](arch, v.compG); sliceG != nil {
+			compG = sliceG.comp
+		}
+
+		var compH []H
+		var retH *H
+		if sliceH := getColumn[H](arch, v.compH); sliceH != nil {
+			compH = sliceH.comp
+		}
+
+		var compI []I
+		var retI *I
+		if sliceI := getColumn[I](arch, v.compI); sliceI != nil {
+			compI = sliceI.comp
+		}
+
+		var compJ []J
+		var retJ *J
+		if sliceJ := getColumn[J](arch, v.compJ); sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+
+		var compK []K
+		var retK *K
+		if sliceK := getColumn[K](arch, v.compK); sliceK != nil {
+			compK = sliceK.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			ticksG := arch.columnTicks(v.compG)
+			ticksH := arch.columnTicks(v.compH)
+			ticksI := arch.columnTicks(v.compI)
+			ticksJ := arch.columnTicks(v.compJ)
+			ticksK := arch.columnTicks(v.compK)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(ids[idx], tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+			} else if sparseJ != nil {
+				retJ = sparseJ.get(ids[idx])
+			}
+			if compK != nil {
+				retK = &compK[idx]
+			} else if sparseK != nil {
+				retK = sparseK.get(ids[idx])
+			}
 
-	// Original - doesn't handle optional
-	// for _, archId := range v.filter.archIds {
-	// 	aSlice, ok := v.storageA.slice[archId]
-	// 	if !ok { continue }
-	// 	bSlice, ok := v.storageB.slice[archId]
-	// 	if !ok { continue }
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+		}
+	})
 
-	// 	lookup, ok := v.world.engine.lookup[archId]
-	// 	if !ok { panic("LookupList is missing!") }
+	v.filter.endRun(v.world, tick)
+}
 
-	// 	ids := lookup.id
-	// 	aComp := aSlice.comp
-	// 	bComp := bSlice.comp
-	// 	if len(ids) != len(aComp) || len(ids) != len(bComp) {
-	// 		panic("ERROR - Bounds don't match")
-	// 	}
-	// 	for i := range ids {
-	// 		if ids[i] == InvalidEntity { continue }
-	// 		lambda(ids[i], &aComp[i], &bComp[i])
-	// 	}
-	// }
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row11 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) All() iter.Seq2[Id, Row11[A, B, C, D, E, F, G, H, I, J, K]] {
+	return func(yield func(Id, Row11[A, B, C, D, E, F, G, H, I, J, K]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		var sliceG *componentSlice[G]
+		var compG []G
+		var retG *G
+		var ticksG []componentTicks
+		sparseG := getSparse[G](v.world.engine, v.compG)
+
+		var sliceH *componentSlice[H]
+		var compH []H
+		var retH *H
+		var ticksH []componentTicks
+		sparseH := getSparse[H](v.world.engine, v.compH)
+
+		var sliceI *componentSlice[I]
+		var compI []I
+		var retI *I
+		var ticksI []componentTicks
+		sparseI := getSparse[I](v.world.engine, v.compI)
+
+		var sliceJ *componentSlice[J]
+		var compJ []J
+		var retJ *J
+		var ticksJ []componentTicks
+		sparseJ := getSparse[J](v.world.engine, v.compJ)
+
+		var sliceK *componentSlice[K]
+		var compK []K
+		var retK *K
+		var ticksK []componentTicks
+		sparseK := getSparse[K](v.world.engine, v.compK)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) || v.world.engine.tracks(v.compK)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+					sliceJ = getColumn[J](arch, v.compJ)
+					ticksJ = arch.columnTicks(v.compJ)
+					compJ = nil
+					if sliceJ != nil {
+						compJ = sliceJ.comp
+					}
+					retJ = nil
+					sliceK = getColumn[K](arch, v.compK)
+					ticksK = arch.columnTicks(v.compK)
+					compK = nil
+					if sliceK != nil {
+						compK = sliceK.comp
+					}
+					retK = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(id, tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(id, tick)
+				}
+
+				if !yield(id, Row11[A, B, C, D, E, F, G, H, I, J, K]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+			sliceG = getColumn[G](arch, v.compG)
+			sliceH = getColumn[H](arch, v.compH)
+			sliceI = getColumn[I](arch, v.compI)
+			sliceJ = getColumn[J](arch, v.compJ)
+			sliceK = getColumn[K](arch, v.compK)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+			retK = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				ticksG = arch.columnTicks(v.compG)
+				ticksH = arch.columnTicks(v.compH)
+				ticksI = arch.columnTicks(v.compI)
+				ticksJ = arch.columnTicks(v.compJ)
+				ticksK = arch.columnTicks(v.compK)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+					if compG != nil {
+						retG = &compG[idx]
+						if ticksG != nil {
+							ticksG[idx].changed = tick
+						}
+					} else if sparseG != nil {
+						retG = sparseG.getChanged(ids[idx], tick)
+					}
+					if compH != nil {
+						retH = &compH[idx]
+						if ticksH != nil {
+							ticksH[idx].changed = tick
+						}
+					} else if sparseH != nil {
+						retH = sparseH.getChanged(ids[idx], tick)
+					}
+					if compI != nil {
+						retI = &compI[idx]
+						if ticksI != nil {
+							ticksI[idx].changed = tick
+						}
+					} else if sparseI != nil {
+						retI = sparseI.getChanged(ids[idx], tick)
+					}
+					if compJ != nil {
+						retJ = &compJ[idx]
+						if ticksJ != nil {
+							ticksJ[idx].changed = tick
+						}
+					} else if sparseJ != nil {
+						retJ = sparseJ.getChanged(ids[idx], tick)
+					}
+					if compK != nil {
+						retK = &compK[idx]
+						if ticksK != nil {
+							ticksK[idx].changed = tick
+						}
+					} else if sparseK != nil {
+						retK = sparseK.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row11[A, B, C, D, E, F, G, H, I, J, K]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				} else if sparseK != nil {
+					retK = sparseK.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row11[A, B, C, D, E, F, G, H, I, J, K]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
 }
 
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -3601,70 +13481,111 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id,
 	sliceListK := make([][]K, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
 			continue
 		}
-		sliceG, ok := v.storageG.slice[archId]
-		if !ok {
+		sliceG := getColumn[G](arch, v.compG)
+		if sliceG == nil {
 			continue
 		}
-		sliceH, ok := v.storageH.slice[archId]
-		if !ok {
+		sliceH := getColumn[H](arch, v.compH)
+		if sliceH == nil {
 			continue
 		}
-		sliceI, ok := v.storageI.slice[archId]
-		if !ok {
+		sliceI := getColumn[I](arch, v.compI)
+		if sliceI == nil {
 			continue
 		}
-		sliceJ, ok := v.storageJ.slice[archId]
-		if !ok {
+		sliceJ := getColumn[J](arch, v.compJ)
+		if sliceJ == nil {
 			continue
 		}
-		sliceK, ok := v.storageK.slice[archId]
-		if !ok {
+		sliceK := getColumn[K](arch, v.compK)
+		if sliceK == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
+		sliceListG = append(sliceListG, sliceG.comp[:len(arch.lookup.id)])
+		sliceListH = append(sliceListH, sliceH.comp[:len(arch.lookup.id)])
+		sliceListI = append(sliceListI, sliceI.comp[:len(arch.lookup.id)])
+		sliceListJ = append(sliceListJ, sliceJ.comp[:len(arch.lookup.id)])
+		sliceListK = append(sliceListK, sliceK.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
-		sliceListI = append(sliceListI, sliceI.comp)
-		sliceListJ = append(sliceListJ, sliceJ.comp)
-		sliceListK = append(sliceListK, sliceK.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
+		ticksG := arch.columnTicks(v.compG)
+		for i := range ticksG {
+			ticksG[i].changed = tick
+		}
+		ticksH := arch.columnTicks(v.compH)
+		for i := range ticksH {
+			ticksH[i].changed = tick
+		}
+		ticksI := arch.columnTicks(v.compI)
+		for i := range ticksI {
+			ticksI[i].changed = tick
+		}
+		ticksJ := arch.columnTicks(v.compJ)
+		for i := range ticksJ {
+			ticksJ[i].changed = tick
+		}
+		ticksK := arch.columnTicks(v.compK)
+		for i := range ticksK {
+			ticksK[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -3672,6 +13593,8 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id,
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx], sliceListK[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
 
 // --------------------------------------------------------------------------------
@@ -3683,84 +13606,74 @@ type View12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
 	world  *World
 	filter filterList
 
-	storageA componentSliceStorage[A]
-	storageB componentSliceStorage[B]
-	storageC componentSliceStorage[C]
-	storageD componentSliceStorage[D]
-	storageE componentSliceStorage[E]
-	storageF componentSliceStorage[F]
-	storageG componentSliceStorage[G]
-	storageH componentSliceStorage[H]
-	storageI componentSliceStorage[I]
-	storageJ componentSliceStorage[J]
-	storageK componentSliceStorage[K]
-	storageL componentSliceStorage[L]
+	compA CompId
+	compB CompId
+	compC CompId
+	compD CompId
+	compE CompId
+	compF CompId
+	compG CompId
+	compH CompId
+	compI CompId
+	compJ CompId
+	compK CompId
+	compL CompId
+}
+
+// Holds pointers to the components of an entity. It is yielded by the All iterator of View12
+type Row12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+	J *J
+	K *K
+	L *L
 }
 
 // Creates a View for the specified world with the specified component filters.
 func Query12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, filters ...Filter) *View12[A, B, C, D, E, F, G, H, I, J, K, L] {
-
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-	storageI := getStorage[I](world.engine)
-	storageJ := getStorage[J](world.engine)
-	storageK := getStorage[K](world.engine)
-	storageL := getStorage[L](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-	var JJ J
-	var KK K
-	var LL L
-
-	comps := []componentId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-		name(JJ),
-		name(KK),
-		name(LL),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+	comps := []CompId{
+
+		nameTyped[A](),
+		nameTyped[B](),
+		nameTyped[C](),
+		nameTyped[D](),
+		nameTyped[E](),
+		nameTyped[F](),
+		nameTyped[G](),
+		nameTyped[H](),
+		nameTyped[I](),
+		nameTyped[J](),
+		nameTyped[K](),
+		nameTyped[L](),
+	}
 
 	v := &View12[A, B, C, D, E, F, G, H, I, J, K, L]{
-		world:  world,
-		filter: filterList,
-
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
-		storageH: storageH,
-		storageI: storageI,
-		storageJ: storageJ,
-		storageK: storageK,
-		storageL: storageL,
-	}
+		world: world,
+
+		compA: comps[0],
+		compB: comps[1],
+		compC: comps[2],
+		compD: comps[3],
+		compE: comps[4],
+		compF: comps[5],
+		compG: comps[6],
+		compH: comps[7],
+		compI: comps[8],
+		compJ: comps[9],
+		compK: comps[10],
+		compL: comps[11],
+	}
+
+	// Note: The filters may reorder the comps list, so this must happen after reading the component ids out of it
+	v.filter = newFilterList(world, comps, filters...)
+	v.filter.regenerate(world)
 	return v
 }
 
@@ -3768,23 +13681,18 @@ func Query12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, filters ...Fi
 // Read will return even if the specified id doesn't match the filter list
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+// Read doesn't mark the components as changed.
 func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, *L) {
 	if id == InvalidEntity {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
-	archId, ok := v.world.arch[id]
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup, ok := v.world.engine.lookup[archId]
-	if !ok {
-		panic("LookupList is missing!")
-	}
-	index, ok := lookup.index[id]
-	if !ok {
+	loc := v.world.engine.locs.get(id)
+	if loc == nil {
 		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
+	arch := v.world.engine.getArchetype(loc.archId)
+	index := loc.index
 
 	var retA *A
 	var retB *B
@@ -3799,130 +13707,386 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Read(id Id) (*A, *B, *C, *D
 	var retK *K
 	var retL *L
 
-	sliceA, ok := v.storageA.slice[archId]
-	if ok {
+	sliceA := getColumn[A](arch, v.compA)
+	if sliceA != nil {
 		retA = &sliceA.comp[index]
+	} else if sparseA := getSparse[A](v.world.engine, v.compA); sparseA != nil {
+		retA = sparseA.get(id)
 	}
-	sliceB, ok := v.storageB.slice[archId]
-	if ok {
+	sliceB := getColumn[B](arch, v.compB)
+	if sliceB != nil {
 		retB = &sliceB.comp[index]
+	} else if sparseB := getSparse[B](v.world.engine, v.compB); sparseB != nil {
+		retB = sparseB.get(id)
 	}
-	sliceC, ok := v.storageC.slice[archId]
-	if ok {
+	sliceC := getColumn[C](arch, v.compC)
+	if sliceC != nil {
 		retC = &sliceC.comp[index]
+	} else if sparseC := getSparse[C](v.world.engine, v.compC); sparseC != nil {
+		retC = sparseC.get(id)
 	}
-	sliceD, ok := v.storageD.slice[archId]
-	if ok {
+	sliceD := getColumn[D](arch, v.compD)
+	if sliceD != nil {
 		retD = &sliceD.comp[index]
+	} else if sparseD := getSparse[D](v.world.engine, v.compD); sparseD != nil {
+		retD = sparseD.get(id)
 	}
-	sliceE, ok := v.storageE.slice[archId]
-	if ok {
+	sliceE := getColumn[E](arch, v.compE)
+	if sliceE != nil {
 		retE = &sliceE.comp[index]
+	} else if sparseE := getSparse[E](v.world.engine, v.compE); sparseE != nil {
+		retE = sparseE.get(id)
 	}
-	sliceF, ok := v.storageF.slice[archId]
-	if ok {
+	sliceF := getColumn[F](arch, v.compF)
+	if sliceF != nil {
 		retF = &sliceF.comp[index]
+	} else if sparseF := getSparse[F](v.world.engine, v.compF); sparseF != nil {
+		retF = sparseF.get(id)
 	}
-	sliceG, ok := v.storageG.slice[archId]
-	if ok {
+	sliceG := getColumn[G](arch, v.compG)
+	if sliceG != nil {
 		retG = &sliceG.comp[index]
+	} else if sparseG := getSparse[G](v.world.engine, v.compG); sparseG != nil {
+		retG = sparseG.get(id)
 	}
-	sliceH, ok := v.storageH.slice[archId]
-	if ok {
+	sliceH := getColumn[H](arch, v.compH)
+	if sliceH != nil {
 		retH = &sliceH.comp[index]
+	} else if sparseH := getSparse[H](v.world.engine, v.compH); sparseH != nil {
+		retH = sparseH.get(id)
 	}
-	sliceI, ok := v.storageI.slice[archId]
-	if ok {
+	sliceI := getColumn[I](arch, v.compI)
+	if sliceI != nil {
 		retI = &sliceI.comp[index]
+	} else if sparseI := getSparse[I](v.world.engine, v.compI); sparseI != nil {
+		retI = sparseI.get(id)
 	}
-	sliceJ, ok := v.storageJ.slice[archId]
-	if ok {
+	sliceJ := getColumn[J](arch, v.compJ)
+	if sliceJ != nil {
 		retJ = &sliceJ.comp[index]
+	} else if sparseJ := getSparse[J](v.world.engine, v.compJ); sparseJ != nil {
+		retJ = sparseJ.get(id)
 	}
-	sliceK, ok := v.storageK.slice[archId]
-	if ok {
+	sliceK := getColumn[K](arch, v.compK)
+	if sliceK != nil {
 		retK = &sliceK.comp[index]
+	} else if sparseK := getSparse[K](v.world.engine, v.compK); sparseK != nil {
+		retK = sparseK.get(id)
 	}
-	sliceL, ok := v.storageL.slice[archId]
-	if ok {
+	sliceL := getColumn[L](arch, v.compL)
+	if sliceL != nil {
 		retL = &sliceL.comp[index]
+	} else if sparseL := getSparse[L](v.world.engine, v.compL); sparseL != nil {
+		retL = sparseL.get(id)
 	}
 
 	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
+// Every component that gets handed to the lambda is marked as changed.
 func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	var sliceA *componentSlice[A]
 	var compA []A
 	var retA *A
+	var ticksA []componentTicks
+	sparseA := getSparse[A](v.world.engine, v.compA)
 
 	var sliceB *componentSlice[B]
 	var compB []B
 	var retB *B
+	var ticksB []componentTicks
+	sparseB := getSparse[B](v.world.engine, v.compB)
 
 	var sliceC *componentSlice[C]
 	var compC []C
 	var retC *C
+	var ticksC []componentTicks
+	sparseC := getSparse[C](v.world.engine, v.compC)
 
 	var sliceD *componentSlice[D]
 	var compD []D
 	var retD *D
+	var ticksD []componentTicks
+	sparseD := getSparse[D](v.world.engine, v.compD)
 
 	var sliceE *componentSlice[E]
 	var compE []E
 	var retE *E
+	var ticksE []componentTicks
+	sparseE := getSparse[E](v.world.engine, v.compE)
 
 	var sliceF *componentSlice[F]
 	var compF []F
 	var retF *F
+	var ticksF []componentTicks
+	sparseF := getSparse[F](v.world.engine, v.compF)
 
 	var sliceG *componentSlice[G]
 	var compG []G
 	var retG *G
+	var ticksG []componentTicks
+	sparseG := getSparse[G](v.world.engine, v.compG)
 
 	var sliceH *componentSlice[H]
 	var compH []H
 	var retH *H
+	var ticksH []componentTicks
+	sparseH := getSparse[H](v.world.engine, v.compH)
 
 	var sliceI *componentSlice[I]
 	var compI []I
 	var retI *I
+	var ticksI []componentTicks
+	sparseI := getSparse[I](v.world.engine, v.compI)
 
 	var sliceJ *componentSlice[J]
 	var compJ []J
 	var retJ *J
+	var ticksJ []componentTicks
+	sparseJ := getSparse[J](v.world.engine, v.compJ)
 
 	var sliceK *componentSlice[K]
 	var compK []K
 	var retK *K
+	var ticksK []componentTicks
+	sparseK := getSparse[K](v.world.engine, v.compK)
 
 	var sliceL *componentSlice[L]
 	var compL []L
 	var retL *L
+	var ticksL []componentTicks
+	sparseL := getSparse[L](v.world.engine, v.compL)
+
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) || v.world.engine.tracks(v.compK) || v.world.engine.tracks(v.compL) // Only components that some view filters with Added or Changed keep ticks, so the others skip stamping
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+		ids := v.filter.takeSparseIds(v.world)
+		var arch *archetype
+		for _, id := range ids {
+			rowArch, idx := v.filter.locate(v.world, id)
+			if rowArch == nil {
+				continue
+			} // Skip if it was deleted or its archetype doesn't match
+			if rowArch != arch {
+				arch = rowArch
+
+				sliceA = getColumn[A](arch, v.compA)
+				ticksA = arch.columnTicks(v.compA)
+				compA = nil
+				if sliceA != nil {
+					compA = sliceA.comp
+				}
+				retA = nil
+				sliceB = getColumn[B](arch, v.compB)
+				ticksB = arch.columnTicks(v.compB)
+				compB = nil
+				if sliceB != nil {
+					compB = sliceB.comp
+				}
+				retB = nil
+				sliceC = getColumn[C](arch, v.compC)
+				ticksC = arch.columnTicks(v.compC)
+				compC = nil
+				if sliceC != nil {
+					compC = sliceC.comp
+				}
+				retC = nil
+				sliceD = getColumn[D](arch, v.compD)
+				ticksD = arch.columnTicks(v.compD)
+				compD = nil
+				if sliceD != nil {
+					compD = sliceD.comp
+				}
+				retD = nil
+				sliceE = getColumn[E](arch, v.compE)
+				ticksE = arch.columnTicks(v.compE)
+				compE = nil
+				if sliceE != nil {
+					compE = sliceE.comp
+				}
+				retE = nil
+				sliceF = getColumn[F](arch, v.compF)
+				ticksF = arch.columnTicks(v.compF)
+				compF = nil
+				if sliceF != nil {
+					compF = sliceF.comp
+				}
+				retF = nil
+				sliceG = getColumn[G](arch, v.compG)
+				ticksG = arch.columnTicks(v.compG)
+				compG = nil
+				if sliceG != nil {
+					compG = sliceG.comp
+				}
+				retG = nil
+				sliceH = getColumn[H](arch, v.compH)
+				ticksH = arch.columnTicks(v.compH)
+				compH = nil
+				if sliceH != nil {
+					compH = sliceH.comp
+				}
+				retH = nil
+				sliceI = getColumn[I](arch, v.compI)
+				ticksI = arch.columnTicks(v.compI)
+				compI = nil
+				if sliceI != nil {
+					compI = sliceI.comp
+				}
+				retI = nil
+				sliceJ = getColumn[J](arch, v.compJ)
+				ticksJ = arch.columnTicks(v.compJ)
+				compJ = nil
+				if sliceJ != nil {
+					compJ = sliceJ.comp
+				}
+				retJ = nil
+				sliceK = getColumn[K](arch, v.compK)
+				ticksK = arch.columnTicks(v.compK)
+				compK = nil
+				if sliceK != nil {
+					compK = sliceK.comp
+				}
+				retK = nil
+				sliceL = getColumn[L](arch, v.compL)
+				ticksL = arch.columnTicks(v.compL)
+				compL = nil
+				if sliceL != nil {
+					compL = sliceL.comp
+				}
+				retL = nil
 
-	for _, archId := range v.filter.archIds {
+			}
+			if !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			}
+
+			if compA != nil {
+				retA = &compA[idx]
+				if ticksA != nil {
+					ticksA[idx].changed = tick
+				}
+			} else if sparseA != nil {
+				retA = sparseA.getChanged(id, tick)
+			}
+			if compB != nil {
+				retB = &compB[idx]
+				if ticksB != nil {
+					ticksB[idx].changed = tick
+				}
+			} else if sparseB != nil {
+				retB = sparseB.getChanged(id, tick)
+			}
+			if compC != nil {
+				retC = &compC[idx]
+				if ticksC != nil {
+					ticksC[idx].changed = tick
+				}
+			} else if sparseC != nil {
+				retC = sparseC.getChanged(id, tick)
+			}
+			if compD != nil {
+				retD = &compD[idx]
+				if ticksD != nil {
+					ticksD[idx].changed = tick
+				}
+			} else if sparseD != nil {
+				retD = sparseD.getChanged(id, tick)
+			}
+			if compE != nil {
+				retE = &compE[idx]
+				if ticksE != nil {
+					ticksE[idx].changed = tick
+				}
+			} else if sparseE != nil {
+				retE = sparseE.getChanged(id, tick)
+			}
+			if compF != nil {
+				retF = &compF[idx]
+				if ticksF != nil {
+					ticksF[idx].changed = tick
+				}
+			} else if sparseF != nil {
+				retF = sparseF.getChanged(id, tick)
+			}
+			if compG != nil {
+				retG = &compG[idx]
+				if ticksG != nil {
+					ticksG[idx].changed = tick
+				}
+			} else if sparseG != nil {
+				retG = sparseG.getChanged(id, tick)
+			}
+			if compH != nil {
+				retH = &compH[idx]
+				if ticksH != nil {
+					ticksH[idx].changed = tick
+				}
+			} else if sparseH != nil {
+				retH = sparseH.getChanged(id, tick)
+			}
+			if compI != nil {
+				retI = &compI[idx]
+				if ticksI != nil {
+					ticksI[idx].changed = tick
+				}
+			} else if sparseI != nil {
+				retI = sparseI.getChanged(id, tick)
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+				if ticksJ != nil {
+					ticksJ[idx].changed = tick
+				}
+			} else if sparseJ != nil {
+				retJ = sparseJ.getChanged(id, tick)
+			}
+			if compK != nil {
+				retK = &compK[idx]
+				if ticksK != nil {
+					ticksK[idx].changed = tick
+				}
+			} else if sparseK != nil {
+				retK = sparseK.getChanged(id, tick)
+			}
+			if compL != nil {
+				retL = &compL[idx]
+				if ticksL != nil {
+					ticksL[idx].changed = tick
+				}
+			} else if sparseL != nil {
+				retL = sparseL.getChanged(id, tick)
+			}
+
+			lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+		}
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
 
-		sliceA, _ = v.storageA.slice[archId]
-		sliceB, _ = v.storageB.slice[archId]
-		sliceC, _ = v.storageC.slice[archId]
-		sliceD, _ = v.storageD.slice[archId]
-		sliceE, _ = v.storageE.slice[archId]
-		sliceF, _ = v.storageF.slice[archId]
-		sliceG, _ = v.storageG.slice[archId]
-		sliceH, _ = v.storageH.slice[archId]
-		sliceI, _ = v.storageI.slice[archId]
-		sliceJ, _ = v.storageJ.slice[archId]
-		sliceK, _ = v.storageK.slice[archId]
-		sliceL, _ = v.storageL.slice[archId]
-
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
-		ids := lookup.id
+	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
+
+		sliceA = getColumn[A](arch, v.compA)
+		sliceB = getColumn[B](arch, v.compB)
+		sliceC = getColumn[C](arch, v.compC)
+		sliceD = getColumn[D](arch, v.compD)
+		sliceE = getColumn[E](arch, v.compE)
+		sliceF = getColumn[F](arch, v.compF)
+		sliceG = getColumn[G](arch, v.compG)
+		sliceH = getColumn[H](arch, v.compH)
+		sliceI = getColumn[I](arch, v.compI)
+		sliceJ = getColumn[J](arch, v.compJ)
+		sliceK = getColumn[K](arch, v.compK)
+		sliceL = getColumn[L](arch, v.compL)
+
+		ids := arch.lookup.id
 
 		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
@@ -3987,47 +14151,200 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a
 		retJ = nil
 		retK = nil
 		retL = nil
+
+		if tracked {
+
+			ticksA = arch.columnTicks(v.compA)
+			ticksB = arch.columnTicks(v.compB)
+			ticksC = arch.columnTicks(v.compC)
+			ticksD = arch.columnTicks(v.compD)
+			ticksE = arch.columnTicks(v.compE)
+			ticksF = arch.columnTicks(v.compF)
+			ticksG = arch.columnTicks(v.compG)
+			ticksH = arch.columnTicks(v.compH)
+			ticksI = arch.columnTicks(v.compI)
+			ticksJ = arch.columnTicks(v.compJ)
+			ticksK = arch.columnTicks(v.compK)
+			ticksL = arch.columnTicks(v.compL)
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(ids[idx], tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(ids[idx], tick)
+				}
+				if compL != nil {
+					retL = &compL[idx]
+					if ticksL != nil {
+						ticksL[idx].changed = tick
+					}
+				} else if sparseL != nil {
+					retL = sparseL.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
 			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
 
 			if compA != nil {
 				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
 			}
 			if compB != nil {
 				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
 			}
 			if compC != nil {
 				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
 			}
 			if compD != nil {
 				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
 			}
 			if compE != nil {
 				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
 			}
 			if compF != nil {
 				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
 			}
 			if compG != nil {
 				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
 			}
 			if compH != nil {
 				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
 			}
 			if compI != nil {
 				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
 			}
 			if compJ != nil {
 				retJ = &compJ[idx]
+			} else if sparseJ != nil {
+				retJ = sparseJ.get(ids[idx])
 			}
 			if compK != nil {
 				retK = &compK[idx]
+			} else if sparseK != nil {
+				retK = sparseK.get(ids[idx])
 			}
 			if compL != nil {
 				retL = &compL[idx]
+			} else if sparseL != nil {
+				retL = sparseL.get(ids[idx])
 			}
+
 			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
 		}
 
@@ -4060,6 +14377,7 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a
 		// 		}
 		// 	}
 	}
+	v.filter.endRun(v.world, tick)
 
 	// Original - doesn't handle optional
 	// for _, archId := range v.filter.archIds {
@@ -4084,9 +14402,1153 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a
 	// }
 }
 
+// Maps the lambda function across every entity which matched the specified filters, split across multiple goroutines.
+// The rows of each archetype are split into chunks of chunkSize rows, which a pool of runtime.GOMAXPROCS(0) workers processes. A chunkSize of 0 or less uses DefaultChunkSize.
+// The lambda gets called concurrently, so it must not modify the world, and it must only modify the components that it is handed. Every component that gets handed to the lambda is marked as changed.
+// Returns once every entity was processed.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdParallel(chunkSize int, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
+	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
+
+	sparseA := getSparse[A](v.world.engine, v.compA)
+	sparseB := getSparse[B](v.world.engine, v.compB)
+	sparseC := getSparse[C](v.world.engine, v.compC)
+	sparseD := getSparse[D](v.world.engine, v.compD)
+	sparseE := getSparse[E](v.world.engine, v.compE)
+	sparseF := getSparse[F](v.world.engine, v.compF)
+	sparseG := getSparse[G](v.world.engine, v.compG)
+	sparseH := getSparse[H](v.world.engine, v.compH)
+	sparseI := getSparse[I](v.world.engine, v.compI)
+	sparseJ := getSparse[J](v.world.engine, v.compJ)
+	sparseK := getSparse[K](v.world.engine, v.compK)
+	sparseL := getSparse[L](v.world.engine, v.compL)
+	rowFilter := v.filter.rowFilter
+	tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) || v.world.engine.tracks(v.compK) || v.world.engine.tracks(v.compL)
+
+	if v.filter.sparseDriven() {
+		// Only the entities in the required sparse set can match, so split them instead of every archetype
+		// Note: Every chunk covers different entities, so the workers never touch the same components or change ticks
+		ids := v.filter.takeSparseIds(v.world)
+		parallelIds(ids, chunkSize, func(ids []Id) {
+
+			var sliceA *componentSlice[A]
+			var compA []A
+			var retA *A
+			var ticksA []componentTicks
+			var sliceB *componentSlice[B]
+			var compB []B
+			var retB *B
+			var ticksB []componentTicks
+			var sliceC *componentSlice[C]
+			var compC []C
+			var retC *C
+			var ticksC []componentTicks
+			var sliceD *componentSlice[D]
+			var compD []D
+			var retD *D
+			var ticksD []componentTicks
+			var sliceE *componentSlice[E]
+			var compE []E
+			var retE *E
+			var ticksE []componentTicks
+			var sliceF *componentSlice[F]
+			var compF []F
+			var retF *F
+			var ticksF []componentTicks
+			var sliceG *componentSlice[G]
+			var compG []G
+			var retG *G
+			var ticksG []componentTicks
+			var sliceH *componentSlice[H]
+			var compH []H
+			var retH *H
+			var ticksH []componentTicks
+			var sliceI *componentSlice[I]
+			var compI []I
+			var retI *I
+			var ticksI []componentTicks
+			var sliceJ *componentSlice[J]
+			var compJ []J
+			var retJ *J
+			var ticksJ []componentTicks
+			var sliceK *componentSlice[K]
+			var compK []K
+			var retK *K
+			var ticksK []componentTicks
+			var sliceL *componentSlice[L]
+			var compL []L
+			var retL *L
+			var ticksL []componentTicks
+
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+					sliceJ = getColumn[J](arch, v.compJ)
+					ticksJ = arch.columnTicks(v.compJ)
+					compJ = nil
+					if sliceJ != nil {
+						compJ = sliceJ.comp
+					}
+					retJ = nil
+					sliceK = getColumn[K](arch, v.compK)
+					ticksK = arch.columnTicks(v.compK)
+					compK = nil
+					if sliceK != nil {
+						compK = sliceK.comp
+					}
+					retK = nil
+					sliceL = getColumn[L](arch, v.compL)
+					ticksL = arch.columnTicks(v.compL)
+					compL = nil
+					if sliceL != nil {
+						compL = sliceL.comp
+					}
+					retL = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(id, tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(id, tick)
+				}
+				if compL != nil {
+					retL = &compL[idx]
+					if ticksL != nil {
+						ticksL[idx].changed = tick
+					}
+				} else if sparseL != nil {
+					retL = sparseL.getChanged(id, tick)
+				}
+
+				lambda(id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+			}
+		})
+		v.filter.releaseSparseIds(ids)
+		v.filter.endRun(v.world, tick)
+		return
+	}
+
+	// Note: Every chunk covers different rows, so the workers never touch the same components or change ticks
+	parallelChunks(v.world, v.filter.archIds, chunkSize, func(arch *archetype, start, end int) {
+
+		var compA []A
+		var retA *A
+		if sliceA := getColumn[A](arch, v.compA); sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		var compB []B
+		var retB *B
+		if sliceB := getColumn[B](arch, v.compB); sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		var compC []C
+		var retC *C
+		if sliceC := getColumn[C](arch, v.compC); sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		var compD []D
+		var retD *D
+		if sliceD := getColumn[D](arch, v.compD); sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		var compE []E
+		var retE *E
+		if sliceE := getColumn[E](arch, v.compE); sliceE != nil {
+			compE = sliceE.comp
+		}
+
+		var compF []F
+		var retF *F
+		if sliceF := getColumn[F](arch, v.compF); sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		var compG []G
+		var retG *G
+		if sliceG := getColumn[G](arch, v.compG); sliceG != nil {
+			compG = sliceG.comp
+		}
+
+		var compH []H
+		var retH *H
+		if sliceH := getColumn[H](arch, v.compH); sliceH != nil {
+			compH = sliceH.comp
+		}
+
+		var compI []I
+		var retI *I
+		if sliceI := getColumn[I](arch, v.compI); sliceI != nil {
+			compI = sliceI.comp
+		}
+
+		var compJ []J
+		var retJ *J
+		if sliceJ := getColumn[J](arch, v.compJ); sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+
+		var compK []K
+		var retK *K
+		if sliceK := getColumn[K](arch, v.compK); sliceK != nil {
+			compK = sliceK.comp
+		}
+
+		var compL []L
+		var retL *L
+		if sliceL := getColumn[L](arch, v.compL); sliceL != nil {
+			compL = sliceL.comp
+		}
+
+		ids := arch.lookup.id
+		if tracked {
+
+			ticksA := arch.columnTicks(v.compA)
+			ticksB := arch.columnTicks(v.compB)
+			ticksC := arch.columnTicks(v.compC)
+			ticksD := arch.columnTicks(v.compD)
+			ticksE := arch.columnTicks(v.compE)
+			ticksF := arch.columnTicks(v.compF)
+			ticksG := arch.columnTicks(v.compG)
+			ticksH := arch.columnTicks(v.compH)
+			ticksI := arch.columnTicks(v.compI)
+			ticksJ := arch.columnTicks(v.compJ)
+			ticksK := arch.columnTicks(v.compK)
+			ticksL := arch.columnTicks(v.compL)
+			for idx := start; idx < end; idx++ {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(ids[idx], tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(ids[idx], tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(ids[idx], tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(ids[idx], tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(ids[idx], tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(ids[idx], tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(ids[idx], tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(ids[idx], tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(ids[idx], tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(ids[idx], tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(ids[idx], tick)
+				}
+				if compL != nil {
+					retL = &compL[idx]
+					if ticksL != nil {
+						ticksL[idx].changed = tick
+					}
+				} else if sparseL != nil {
+					retL = sparseL.getChanged(ids[idx], tick)
+				}
+
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+			}
+			return
+		}
+
+		for idx := start; idx < end; idx++ {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+				continue
+			} // Skip if it doesn't match the sparse components or change ticks
+
+			if compA != nil {
+				retA = &compA[idx]
+			} else if sparseA != nil {
+				retA = sparseA.get(ids[idx])
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			} else if sparseB != nil {
+				retB = sparseB.get(ids[idx])
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			} else if sparseC != nil {
+				retC = sparseC.get(ids[idx])
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			} else if sparseD != nil {
+				retD = sparseD.get(ids[idx])
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			} else if sparseE != nil {
+				retE = sparseE.get(ids[idx])
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			} else if sparseF != nil {
+				retF = sparseF.get(ids[idx])
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			} else if sparseG != nil {
+				retG = sparseG.get(ids[idx])
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			} else if sparseH != nil {
+				retH = sparseH.get(ids[idx])
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			} else if sparseI != nil {
+				retI = sparseI.get(ids[idx])
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+			} else if sparseJ != nil {
+				retJ = sparseJ.get(ids[idx])
+			}
+			if compK != nil {
+				retK = &compK[idx]
+			} else if sparseK != nil {
+				retK = sparseK.get(ids[idx])
+			}
+			if compL != nil {
+				retL = &compL[idx]
+			} else if sparseL != nil {
+				retL = sparseL.get(ids[idx])
+			}
+
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+		}
+	})
+
+	v.filter.endRun(v.world, tick)
+}
+
+// Returns an iterator over every entity which matched the specified filters. It yields the id of each entity with a Row12 of pointers to its components, so that the loop can break or return early:
+//
+//	for id, row := range query.All() { ... }
+//
+// Every component that gets yielded is marked as changed. The same caveats as MapId apply to modifying the world inside of the loop.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) All() iter.Seq2[Id, Row12[A, B, C, D, E, F, G, H, I, J, K, L]] {
+	return func(yield func(Id, Row12[A, B, C, D, E, F, G, H, I, J, K, L]) bool) {
+		v.filter.regenerate(v.world)
+		tick := v.filter.beginRun(v.world)
+
+		var sliceA *componentSlice[A]
+		var compA []A
+		var retA *A
+		var ticksA []componentTicks
+		sparseA := getSparse[A](v.world.engine, v.compA)
+
+		var sliceB *componentSlice[B]
+		var compB []B
+		var retB *B
+		var ticksB []componentTicks
+		sparseB := getSparse[B](v.world.engine, v.compB)
+
+		var sliceC *componentSlice[C]
+		var compC []C
+		var retC *C
+		var ticksC []componentTicks
+		sparseC := getSparse[C](v.world.engine, v.compC)
+
+		var sliceD *componentSlice[D]
+		var compD []D
+		var retD *D
+		var ticksD []componentTicks
+		sparseD := getSparse[D](v.world.engine, v.compD)
+
+		var sliceE *componentSlice[E]
+		var compE []E
+		var retE *E
+		var ticksE []componentTicks
+		sparseE := getSparse[E](v.world.engine, v.compE)
+
+		var sliceF *componentSlice[F]
+		var compF []F
+		var retF *F
+		var ticksF []componentTicks
+		sparseF := getSparse[F](v.world.engine, v.compF)
+
+		var sliceG *componentSlice[G]
+		var compG []G
+		var retG *G
+		var ticksG []componentTicks
+		sparseG := getSparse[G](v.world.engine, v.compG)
+
+		var sliceH *componentSlice[H]
+		var compH []H
+		var retH *H
+		var ticksH []componentTicks
+		sparseH := getSparse[H](v.world.engine, v.compH)
+
+		var sliceI *componentSlice[I]
+		var compI []I
+		var retI *I
+		var ticksI []componentTicks
+		sparseI := getSparse[I](v.world.engine, v.compI)
+
+		var sliceJ *componentSlice[J]
+		var compJ []J
+		var retJ *J
+		var ticksJ []componentTicks
+		sparseJ := getSparse[J](v.world.engine, v.compJ)
+
+		var sliceK *componentSlice[K]
+		var compK []K
+		var retK *K
+		var ticksK []componentTicks
+		sparseK := getSparse[K](v.world.engine, v.compK)
+
+		var sliceL *componentSlice[L]
+		var compL []L
+		var retL *L
+		var ticksL []componentTicks
+		sparseL := getSparse[L](v.world.engine, v.compL)
+
+		rowFilter := v.filter.rowFilter
+		tracked := v.world.engine.tracks(v.compA) || v.world.engine.tracks(v.compB) || v.world.engine.tracks(v.compC) || v.world.engine.tracks(v.compD) || v.world.engine.tracks(v.compE) || v.world.engine.tracks(v.compF) || v.world.engine.tracks(v.compG) || v.world.engine.tracks(v.compH) || v.world.engine.tracks(v.compI) || v.world.engine.tracks(v.compJ) || v.world.engine.tracks(v.compK) || v.world.engine.tracks(v.compL)
+
+		if v.filter.sparseDriven() {
+			// Only the entities in the required sparse set can match, so iterate them instead of every archetype
+			ids := v.filter.takeSparseIds(v.world)
+			var arch *archetype
+			for _, id := range ids {
+				rowArch, idx := v.filter.locate(v.world, id)
+				if rowArch == nil {
+					continue
+				} // Skip if it was deleted or its archetype doesn't match
+				if rowArch != arch {
+					arch = rowArch
+
+					sliceA = getColumn[A](arch, v.compA)
+					ticksA = arch.columnTicks(v.compA)
+					compA = nil
+					if sliceA != nil {
+						compA = sliceA.comp
+					}
+					retA = nil
+					sliceB = getColumn[B](arch, v.compB)
+					ticksB = arch.columnTicks(v.compB)
+					compB = nil
+					if sliceB != nil {
+						compB = sliceB.comp
+					}
+					retB = nil
+					sliceC = getColumn[C](arch, v.compC)
+					ticksC = arch.columnTicks(v.compC)
+					compC = nil
+					if sliceC != nil {
+						compC = sliceC.comp
+					}
+					retC = nil
+					sliceD = getColumn[D](arch, v.compD)
+					ticksD = arch.columnTicks(v.compD)
+					compD = nil
+					if sliceD != nil {
+						compD = sliceD.comp
+					}
+					retD = nil
+					sliceE = getColumn[E](arch, v.compE)
+					ticksE = arch.columnTicks(v.compE)
+					compE = nil
+					if sliceE != nil {
+						compE = sliceE.comp
+					}
+					retE = nil
+					sliceF = getColumn[F](arch, v.compF)
+					ticksF = arch.columnTicks(v.compF)
+					compF = nil
+					if sliceF != nil {
+						compF = sliceF.comp
+					}
+					retF = nil
+					sliceG = getColumn[G](arch, v.compG)
+					ticksG = arch.columnTicks(v.compG)
+					compG = nil
+					if sliceG != nil {
+						compG = sliceG.comp
+					}
+					retG = nil
+					sliceH = getColumn[H](arch, v.compH)
+					ticksH = arch.columnTicks(v.compH)
+					compH = nil
+					if sliceH != nil {
+						compH = sliceH.comp
+					}
+					retH = nil
+					sliceI = getColumn[I](arch, v.compI)
+					ticksI = arch.columnTicks(v.compI)
+					compI = nil
+					if sliceI != nil {
+						compI = sliceI.comp
+					}
+					retI = nil
+					sliceJ = getColumn[J](arch, v.compJ)
+					ticksJ = arch.columnTicks(v.compJ)
+					compJ = nil
+					if sliceJ != nil {
+						compJ = sliceJ.comp
+					}
+					retJ = nil
+					sliceK = getColumn[K](arch, v.compK)
+					ticksK = arch.columnTicks(v.compK)
+					compK = nil
+					if sliceK != nil {
+						compK = sliceK.comp
+					}
+					retK = nil
+					sliceL = getColumn[L](arch, v.compL)
+					ticksL = arch.columnTicks(v.compL)
+					compL = nil
+					if sliceL != nil {
+						compL = sliceL.comp
+					}
+					retL = nil
+
+				}
+				if !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				}
+
+				if compA != nil {
+					retA = &compA[idx]
+					if ticksA != nil {
+						ticksA[idx].changed = tick
+					}
+				} else if sparseA != nil {
+					retA = sparseA.getChanged(id, tick)
+				}
+				if compB != nil {
+					retB = &compB[idx]
+					if ticksB != nil {
+						ticksB[idx].changed = tick
+					}
+				} else if sparseB != nil {
+					retB = sparseB.getChanged(id, tick)
+				}
+				if compC != nil {
+					retC = &compC[idx]
+					if ticksC != nil {
+						ticksC[idx].changed = tick
+					}
+				} else if sparseC != nil {
+					retC = sparseC.getChanged(id, tick)
+				}
+				if compD != nil {
+					retD = &compD[idx]
+					if ticksD != nil {
+						ticksD[idx].changed = tick
+					}
+				} else if sparseD != nil {
+					retD = sparseD.getChanged(id, tick)
+				}
+				if compE != nil {
+					retE = &compE[idx]
+					if ticksE != nil {
+						ticksE[idx].changed = tick
+					}
+				} else if sparseE != nil {
+					retE = sparseE.getChanged(id, tick)
+				}
+				if compF != nil {
+					retF = &compF[idx]
+					if ticksF != nil {
+						ticksF[idx].changed = tick
+					}
+				} else if sparseF != nil {
+					retF = sparseF.getChanged(id, tick)
+				}
+				if compG != nil {
+					retG = &compG[idx]
+					if ticksG != nil {
+						ticksG[idx].changed = tick
+					}
+				} else if sparseG != nil {
+					retG = sparseG.getChanged(id, tick)
+				}
+				if compH != nil {
+					retH = &compH[idx]
+					if ticksH != nil {
+						ticksH[idx].changed = tick
+					}
+				} else if sparseH != nil {
+					retH = sparseH.getChanged(id, tick)
+				}
+				if compI != nil {
+					retI = &compI[idx]
+					if ticksI != nil {
+						ticksI[idx].changed = tick
+					}
+				} else if sparseI != nil {
+					retI = sparseI.getChanged(id, tick)
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+					if ticksJ != nil {
+						ticksJ[idx].changed = tick
+					}
+				} else if sparseJ != nil {
+					retJ = sparseJ.getChanged(id, tick)
+				}
+				if compK != nil {
+					retK = &compK[idx]
+					if ticksK != nil {
+						ticksK[idx].changed = tick
+					}
+				} else if sparseK != nil {
+					retK = sparseK.getChanged(id, tick)
+				}
+				if compL != nil {
+					retL = &compL[idx]
+					if ticksL != nil {
+						ticksL[idx].changed = tick
+					}
+				} else if sparseL != nil {
+					retL = sparseL.getChanged(id, tick)
+				}
+
+				if !yield(id, Row12[A, B, C, D, E, F, G, H, I, J, K, L]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL}) {
+					break
+				}
+			}
+			v.filter.releaseSparseIds(ids)
+			v.filter.endRun(v.world, tick)
+			return
+		}
+
+		// Note: This is laid out just like MapId, so that both run the same loops
+		for _, archId := range v.filter.archIds {
+			arch := v.world.engine.getArchetype(archId)
+
+			sliceA = getColumn[A](arch, v.compA)
+			sliceB = getColumn[B](arch, v.compB)
+			sliceC = getColumn[C](arch, v.compC)
+			sliceD = getColumn[D](arch, v.compD)
+			sliceE = getColumn[E](arch, v.compE)
+			sliceF = getColumn[F](arch, v.compF)
+			sliceG = getColumn[G](arch, v.compG)
+			sliceH = getColumn[H](arch, v.compH)
+			sliceI = getColumn[I](arch, v.compI)
+			sliceJ = getColumn[J](arch, v.compJ)
+			sliceK = getColumn[K](arch, v.compK)
+			sliceL = getColumn[L](arch, v.compL)
+
+			ids := arch.lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+			compL = nil
+			if sliceL != nil {
+				compL = sliceL.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+			retK = nil
+			retL = nil
+
+			if tracked {
+
+				ticksA = arch.columnTicks(v.compA)
+				ticksB = arch.columnTicks(v.compB)
+				ticksC = arch.columnTicks(v.compC)
+				ticksD = arch.columnTicks(v.compD)
+				ticksE = arch.columnTicks(v.compE)
+				ticksF = arch.columnTicks(v.compF)
+				ticksG = arch.columnTicks(v.compG)
+				ticksH = arch.columnTicks(v.compH)
+				ticksI = arch.columnTicks(v.compI)
+				ticksJ = arch.columnTicks(v.compJ)
+				ticksK = arch.columnTicks(v.compK)
+				ticksL = arch.columnTicks(v.compL)
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+						continue
+					} // Skip if it doesn't match the sparse components or change ticks
+
+					if compA != nil {
+						retA = &compA[idx]
+						if ticksA != nil {
+							ticksA[idx].changed = tick
+						}
+					} else if sparseA != nil {
+						retA = sparseA.getChanged(ids[idx], tick)
+					}
+					if compB != nil {
+						retB = &compB[idx]
+						if ticksB != nil {
+							ticksB[idx].changed = tick
+						}
+					} else if sparseB != nil {
+						retB = sparseB.getChanged(ids[idx], tick)
+					}
+					if compC != nil {
+						retC = &compC[idx]
+						if ticksC != nil {
+							ticksC[idx].changed = tick
+						}
+					} else if sparseC != nil {
+						retC = sparseC.getChanged(ids[idx], tick)
+					}
+					if compD != nil {
+						retD = &compD[idx]
+						if ticksD != nil {
+							ticksD[idx].changed = tick
+						}
+					} else if sparseD != nil {
+						retD = sparseD.getChanged(ids[idx], tick)
+					}
+					if compE != nil {
+						retE = &compE[idx]
+						if ticksE != nil {
+							ticksE[idx].changed = tick
+						}
+					} else if sparseE != nil {
+						retE = sparseE.getChanged(ids[idx], tick)
+					}
+					if compF != nil {
+						retF = &compF[idx]
+						if ticksF != nil {
+							ticksF[idx].changed = tick
+						}
+					} else if sparseF != nil {
+						retF = sparseF.getChanged(ids[idx], tick)
+					}
+					if compG != nil {
+						retG = &compG[idx]
+						if ticksG != nil {
+							ticksG[idx].changed = tick
+						}
+					} else if sparseG != nil {
+						retG = sparseG.getChanged(ids[idx], tick)
+					}
+					if compH != nil {
+						retH = &compH[idx]
+						if ticksH != nil {
+							ticksH[idx].changed = tick
+						}
+					} else if sparseH != nil {
+						retH = sparseH.getChanged(ids[idx], tick)
+					}
+					if compI != nil {
+						retI = &compI[idx]
+						if ticksI != nil {
+							ticksI[idx].changed = tick
+						}
+					} else if sparseI != nil {
+						retI = sparseI.getChanged(ids[idx], tick)
+					}
+					if compJ != nil {
+						retJ = &compJ[idx]
+						if ticksJ != nil {
+							ticksJ[idx].changed = tick
+						}
+					} else if sparseJ != nil {
+						retJ = sparseJ.getChanged(ids[idx], tick)
+					}
+					if compK != nil {
+						retK = &compK[idx]
+						if ticksK != nil {
+							ticksK[idx].changed = tick
+						}
+					} else if sparseK != nil {
+						retK = sparseK.getChanged(ids[idx], tick)
+					}
+					if compL != nil {
+						retL = &compL[idx]
+						if ticksL != nil {
+							ticksL[idx].changed = tick
+						}
+					} else if sparseL != nil {
+						retL = sparseL.getChanged(ids[idx], tick)
+					}
+
+					if !yield(ids[idx], Row12[A, B, C, D, E, F, G, H, I, J, K, L]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL}) {
+						v.filter.endRun(v.world, tick)
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if rowFilter && !v.filter.matchRow(v.world, arch, idx) {
+					continue
+				} // Skip if it doesn't match the sparse components or change ticks
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				} else if sparseK != nil {
+					retK = sparseK.get(ids[idx])
+				}
+				if compL != nil {
+					retL = &compL[idx]
+				} else if sparseL != nil {
+					retL = sparseL.get(ids[idx])
+				}
+
+				if !yield(ids[idx], Row12[A, B, C, D, E, F, G, H, I, J, K, L]{retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL}) {
+					v.filter.endRun(v.world, tick)
+					return
+				}
+			}
+		}
+		v.filter.endRun(v.world, tick)
+	}
+}
+
 // Deprecated: This API is a tentative alternative way to map
+// Sparse components aren't stored in archetype slices, so this panics if the view's filters depend on any sparse components or change ticks
+// Every component in the slices is marked as changed.
 func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K, l []L)) {
+	if v.filter.rowFilter {
+		panic("ecs: MapSlices doesn't support sparse components or change filters")
+	}
 	v.filter.regenerate(v.world)
+	tick := v.filter.beginRun(v.world)
 
 	id := make([][]Id, 0)
 
@@ -4104,75 +15566,120 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapSlices(lambda func(id []
 	sliceListL := make([][]L, 0)
 
 	for _, archId := range v.filter.archIds {
+		arch := v.world.engine.getArchetype(archId)
 
-		sliceA, ok := v.storageA.slice[archId]
-		if !ok {
+		sliceA := getColumn[A](arch, v.compA)
+		if sliceA == nil {
 			continue
 		}
-		sliceB, ok := v.storageB.slice[archId]
-		if !ok {
+		sliceB := getColumn[B](arch, v.compB)
+		if sliceB == nil {
 			continue
 		}
-		sliceC, ok := v.storageC.slice[archId]
-		if !ok {
+		sliceC := getColumn[C](arch, v.compC)
+		if sliceC == nil {
 			continue
 		}
-		sliceD, ok := v.storageD.slice[archId]
-		if !ok {
+		sliceD := getColumn[D](arch, v.compD)
+		if sliceD == nil {
 			continue
 		}
-		sliceE, ok := v.storageE.slice[archId]
-		if !ok {
+		sliceE := getColumn[E](arch, v.compE)
+		if sliceE == nil {
 			continue
 		}
-		sliceF, ok := v.storageF.slice[archId]
-		if !ok {
+		sliceF := getColumn[F](arch, v.compF)
+		if sliceF == nil {
 			continue
 		}
-		sliceG, ok := v.storageG.slice[archId]
-		if !ok {
+		sliceG := getColumn[G](arch, v.compG)
+		if sliceG == nil {
 			continue
 		}
-		sliceH, ok := v.storageH.slice[archId]
-		if !ok {
+		sliceH := getColumn[H](arch, v.compH)
+		if sliceH == nil {
 			continue
 		}
-		sliceI, ok := v.storageI.slice[archId]
-		if !ok {
+		sliceI := getColumn[I](arch, v.compI)
+		if sliceI == nil {
 			continue
 		}
-		sliceJ, ok := v.storageJ.slice[archId]
-		if !ok {
+		sliceJ := getColumn[J](arch, v.compJ)
+		if sliceJ == nil {
 			continue
 		}
-		sliceK, ok := v.storageK.slice[archId]
-		if !ok {
+		sliceK := getColumn[K](arch, v.compK)
+		if sliceK == nil {
 			continue
 		}
-		sliceL, ok := v.storageL.slice[archId]
-		if !ok {
+		sliceL := getColumn[L](arch, v.compL)
+		if sliceL == nil {
 			continue
 		}
 
-		lookup, ok := v.world.engine.lookup[archId]
-		if !ok {
-			panic("LookupList is missing!")
-		}
+		id = append(id, arch.lookup.id)
 
-		id = append(id, lookup.id)
+		sliceListA = append(sliceListA, sliceA.comp[:len(arch.lookup.id)])
+		sliceListB = append(sliceListB, sliceB.comp[:len(arch.lookup.id)])
+		sliceListC = append(sliceListC, sliceC.comp[:len(arch.lookup.id)])
+		sliceListD = append(sliceListD, sliceD.comp[:len(arch.lookup.id)])
+		sliceListE = append(sliceListE, sliceE.comp[:len(arch.lookup.id)])
+		sliceListF = append(sliceListF, sliceF.comp[:len(arch.lookup.id)])
+		sliceListG = append(sliceListG, sliceG.comp[:len(arch.lookup.id)])
+		sliceListH = append(sliceListH, sliceH.comp[:len(arch.lookup.id)])
+		sliceListI = append(sliceListI, sliceI.comp[:len(arch.lookup.id)])
+		sliceListJ = append(sliceListJ, sliceJ.comp[:len(arch.lookup.id)])
+		sliceListK = append(sliceListK, sliceK.comp[:len(arch.lookup.id)])
+		sliceListL = append(sliceListL, sliceL.comp[:len(arch.lookup.id)])
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
-		sliceListI = append(sliceListI, sliceI.comp)
-		sliceListJ = append(sliceListJ, sliceJ.comp)
-		sliceListK = append(sliceListK, sliceK.comp)
-		sliceListL = append(sliceListL, sliceL.comp)
+		ticksA := arch.columnTicks(v.compA)
+		for i := range ticksA {
+			ticksA[i].changed = tick
+		}
+		ticksB := arch.columnTicks(v.compB)
+		for i := range ticksB {
+			ticksB[i].changed = tick
+		}
+		ticksC := arch.columnTicks(v.compC)
+		for i := range ticksC {
+			ticksC[i].changed = tick
+		}
+		ticksD := arch.columnTicks(v.compD)
+		for i := range ticksD {
+			ticksD[i].changed = tick
+		}
+		ticksE := arch.columnTicks(v.compE)
+		for i := range ticksE {
+			ticksE[i].changed = tick
+		}
+		ticksF := arch.columnTicks(v.compF)
+		for i := range ticksF {
+			ticksF[i].changed = tick
+		}
+		ticksG := arch.columnTicks(v.compG)
+		for i := range ticksG {
+			ticksG[i].changed = tick
+		}
+		ticksH := arch.columnTicks(v.compH)
+		for i := range ticksH {
+			ticksH[i].changed = tick
+		}
+		ticksI := arch.columnTicks(v.compI)
+		for i := range ticksI {
+			ticksI[i].changed = tick
+		}
+		ticksJ := arch.columnTicks(v.compJ)
+		for i := range ticksJ {
+			ticksJ[i].changed = tick
+		}
+		ticksK := arch.columnTicks(v.compK)
+		for i := range ticksK {
+			ticksK[i].changed = tick
+		}
+		ticksL := arch.columnTicks(v.compL)
+		for i := range ticksL {
+			ticksL[i].changed = tick
+		}
 	}
 
 	for idx := range id {
@@ -4180,4 +15687,6 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapSlices(lambda func(id []
 			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx], sliceListK[idx], sliceListL[idx],
 		)
 	}
+
+	v.filter.endRun(v.world, tick)
 }
@@ -0,0 +1,131 @@
+package ecs
+
+import (
+	"testing"
+)
+
+func TestViewAll(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 10)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{x: float64(i)}), C(velocity{1, 1, 1}))
+	}
+	Delete(world, ids[3]) // Leaves a hole
+	Write(world, world.NewId(), C(position{}))
+
+	query := Query2[position, velocity](world)
+	count := 0
+	for id, row := range query.All() {
+		check(t, id != ids[3])
+		row.A.x += row.B.x
+		count++
+	}
+	compare(t, count, 9)
+	for i, id := range ids {
+		pos, ok := Read[position](world, id)
+		if i == 3 {
+			check(t, !ok)
+			continue
+		}
+		compare(t, pos.x, float64(i+1))
+	}
+
+	// Breaking out of the loop early
+	count = 0
+	for range query.All() {
+		count++
+		if count == 4 {
+			break
+		}
+	}
+	compare(t, count, 4)
+
+	// Single component views yield the pointer directly
+	count = 0
+	for id, pos := range Query1[position](world, Without(velocity{})).All() {
+		check(t, world.Exists(id))
+		compare(t, pos.x, 0.0)
+		count++
+	}
+	compare(t, count, 1)
+
+	// Optional components are nil if they are missing
+	count = 0
+	for _, row := range Query2[position, velocity](world, Optional(velocity{})).All() {
+		if row.B == nil {
+			count++
+		}
+	}
+	compare(t, count, 1)
+
+	// Iterating doesn't allocate
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, row := range query.All() {
+			row.A.y += row.B.y
+		}
+	})
+	compare(t, allocs, 0.0)
+}
+
+func TestViewMapIdParallel(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+
+	ids := make([]Id, 10_000)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{x: float64(i)}), C(velocity{1, 2, 3}))
+		if i%2 == 0 {
+			Write(world, ids[i], C(radius{}))
+		}
+		if i%3 == 0 {
+			Write(world, ids[i], C(selected{by: 1}))
+		}
+		if i%5 == 0 {
+			Delete(world, ids[i]) // Leave holes
+		}
+	}
+
+	query := Query3[position, velocity, selected](world, Optional(selected{}))
+	for _, chunkSize := range []int{0, 1, 7, 100_000} {
+		query.MapIdParallel(chunkSize, func(id Id, p *position, v *velocity, s *selected) {
+			p.y += v.y
+			if s != nil {
+				s.by++
+			}
+		})
+	}
+
+	for i, id := range ids {
+		pos, ok := Read[position](world, id)
+		if i%5 == 0 {
+			check(t, !ok)
+			continue
+		}
+		compare(t, pos.y, 8.0)
+		if i%3 == 0 {
+			sel, _ := Read[selected](world, id)
+			compare(t, sel.by, 5)
+		}
+	}
+
+	// Change filters see the components that were handed out
+	changed := Query1[position](world, Changed[velocity]())
+	changed.MapId(func(id Id, p *position) {})
+	count := 0
+	changed.MapId(func(id Id, p *position) { count++ })
+	compare(t, count, 0)
+	Query1[velocity](world).MapIdParallel(16, func(id Id, v *velocity) {})
+	changed.MapId(func(id Id, p *position) { count++ })
+	compare(t, count, 8000)
+
+	// Panics get raised on the calling goroutine
+	defer func() {
+		check(t, recover() == "boom")
+	}()
+	query.MapIdParallel(1, func(id Id, p *position, v *velocity, s *selected) {
+		panic("boom")
+	})
+	t.Fatal("MapIdParallel didn't panic")
+}
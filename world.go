@@ -2,30 +2,35 @@ package ecs
 
 import (
 	"math"
+	"sort"
 )
 
 const (
 	InvalidEntity Id = 0 // Represents the default entity Id, which is invalid
 	firstEntity   Id = 1
-	MaxEntity     Id = math.MaxUint32
+	MaxEntity     Id = math.MaxUint32 // The largest entity index
 )
 
 // World is the main data-holder. You usually pass it to other functions to do things.
 type World struct {
 	nextId       Id
-	minId, maxId Id // This is the range of Ids returned by NewId
-	arch         map[Id]archetypeId
+	minId, maxId Id   // This is the range of Ids returned by NewId
+	freeIds      []Id // Deleted Ids (with their generation already incremented) which NewId will recycle
 	engine       *archEngine
+	hooks        []*componentHooks // Indexed by CompId, nil if the component has no hooks
+	deleting     []Id              // Entities whose OnRemove hooks are currently running inside of Delete
+	resources    map[any]any       // Maps typeKey[T] to the *T of each resource
+	views        int               // The number of views that were created for this world
 }
 
 // Creates a new world
 func NewWorld() *World {
 	return &World{
-		nextId: firstEntity + 1,
-		minId:  firstEntity + 1,
-		maxId:  MaxEntity,
-		arch:   make(map[Id]archetypeId),
-		engine: newArchEngine(),
+		nextId:  firstEntity + 1,
+		minId:   firstEntity + 1,
+		maxId:   MaxEntity,
+		freeIds: make([]Id, 0),
+		engine:  newArchEngine(),
 	}
 }
 
@@ -38,6 +43,9 @@ func (w *World) SetIdRange(min, max Id) {
 	if max <= firstEntity {
 		panic("max must be greater than 1")
 	}
+	if max > MaxEntity {
+		panic("max must not be greater than MaxEntity") // Larger Ids would carry generation bits
+	}
 	if min > max {
 		panic("min must be less than max!")
 	}
@@ -47,47 +55,50 @@ func (w *World) SetIdRange(min, max Id) {
 }
 
 // Creates a new Id which can then be used to create an entity
+// Ids of deleted entities get recycled with an incremented generation. Panics if every Id in the range is in use
 func (w *World) NewId() Id {
+	if len(w.freeIds) > 0 {
+		id := w.freeIds[len(w.freeIds)-1]
+		w.freeIds = w.freeIds[:len(w.freeIds)-1]
+		return id
+	}
+
 	if w.nextId < w.minId {
 		w.nextId = w.minId
 	}
+	if w.nextId > w.maxId {
+		panic("ecs: Ran out of entity Ids")
+	}
 
 	id := w.nextId
-
-	if w.nextId == w.maxId {
-		w.nextId = w.minId
-	} else {
-		w.nextId++
-	}
+	w.nextId++
 	return id
 }
 
-// func (w *World) Count(anything ...any) int {
-// 	return w.engine.Count(anything...)
-// }
-
-// func (w *World) Print(amount int) {
-// 	fmt.Println("--- World ---")
-// 	fmt.Printf("nextId: %d\n", w.nextId)
+// Returns true if the index of the id was handed out by NewId, meaning that the world manages its generation
+func (w *World) isManaged(id Id) bool {
+	index := Id(id.Index())
+	return index >= w.minId && index < w.nextId
+}
 
-// 	// max := amount
-// 	// for id, archId := range w.arch {
-// 	// 	fmt.Printf("id(%d) -> archId(%d)\n", id, archId)
-// 	// 	max--; if max <= 0 { break }
-// 	// }
+// Returns true if the index of the id is owned or reserved by a different generation
+func (w *World) isStale(id Id) bool {
+	loc := w.engine.locs.slot(id)
+	return loc != nil && loc.id != InvalidEntity && loc.id != id
+}
 
-// 	// w.engine.Print(amount)
-// }
+// Releases the index of the id so that NewId can recycle it with the next generation
+func (w *World) free(id Id) {
+	if !w.isManaged(id) {
+		return // User managed Ids don't get recycled
+	}
+	next := newId(id.Index(), id.Generation()+1)
+	w.engine.locs.slot(id).id = next // Reserve the index for the next generation
+	w.freeIds = append(w.freeIds, next)
+}
 
-// // A debug function for describing the current state of memory allocations in the ECS
-// func (w *World) DescribeMemory() {
-// 	fmt.Println("--- World ---")
-// 	fmt.Printf("nextId: %d\n", w.nextId)
-// 	fmt.Printf("Active Ent Count: %d\n", len(w.arch))
-// 	for archId, lookup := range w.engine.lookup {
-// 		efficiency := 100 * (1.0 - float64(len(lookup.holes))/float64(len(lookup.id)))
-// 		fmt.Printf("Lookup[%d] = {len(index)=%d, len(id)=%d, len(holes)=%d} | Efficiency=%.2f%%\n", archId, len(lookup.index), len(lookup.id), len(lookup.holes), efficiency)
-// 	}
+// func (w *World) Count(anything ...any) int {
+// 	return w.engine.Count(anything...)
 // }
 
 // TODO - Note: This function is not safe inside Maps or view iteraions
@@ -110,14 +121,54 @@ func Write(world *World, id Id, comp ...Component) {
 func (world *World) Write(id Id, comp ...Component) {
 	if len(comp) <= 0 { return } // Do nothing if there are no components
 
-	archId, ok := world.arch[id]
-	if ok {
-		newarchetypeId := world.engine.rewriteArch(archId, id, comp...)
-		world.arch[id] = newarchetypeId
+	if !world.anyHooks(comp) {
+		world.write(id, comp...)
+		return
+	}
+
+	// Remember which components the entity already had, so we know which ones got added and which ones got overwritten
+	had := make([]bool, len(comp))
+	for i := range comp {
+		if world.getHooks(comp[i].id()) == nil {
+			continue
+		}
+		_, index := world.engine.componentRow(id, comp[i].id())
+		had[i] = index >= 0
+	}
+
+	world.write(id, comp...)
+
+	for i := range comp {
+		compId := comp[i].id()
+		h := world.getHooks(compId)
+		if h == nil {
+			continue
+		}
+		if had[i] {
+			world.fireHooks(h.onSet, id, compId)
+		} else {
+			world.fireHooks(h.onAdd, id, compId)
+		}
+	}
+}
+
+func (world *World) write(id Id, comp ...Component) {
+	if world.engine.anySparse(comp) {
+		world.writeSparse(id, comp)
+		return
+	}
+
+	loc := world.engine.locs.get(id)
+	if loc != nil {
+		world.engine.rewriteArch(loc.archId, id, comp...)
 	} else {
+		// Do nothing if the id belongs to a deleted entity
+		if world.isStale(id) {
+			return
+		}
+
 		// Id does not yet exist, we need to add it for the first time
-		archId = world.engine.GetarchetypeId(comp...)
-		world.arch[id] = archId
+		archId := world.engine.GetarchetypeId(comp...)
 
 		// Write all components to that archetype
 		// TODO - Push this inward for efficiency?
@@ -127,17 +178,132 @@ func (world *World) Write(id Id, comp ...Component) {
 	}
 }
 
+// Writes the archetype components of the entity like normal, then writes the sparse components into their sets
+func (world *World) writeSparse(id Id, comp []Component) {
+	dense := make([]Component, 0, len(comp))
+	for i := range comp {
+		if world.engine.getSparseStorage(comp[i].id()) == nil {
+			dense = append(dense, comp[i])
+		}
+	}
+
+	if len(dense) > 0 {
+		world.write(id, dense...)
+	} else if !world.Exists(id) && !world.isStale(id) {
+		// The entity only has sparse components, so it goes into the archetype without any components
+		world.engine.allocate(world.engine.dcr.getArchetypeId([]CompId{}), id)
+	}
+
+	if !world.Exists(id) {
+		return // The id belongs to a deleted entity
+	}
+	for i := range comp {
+		if world.engine.getSparseStorage(comp[i].id()) != nil {
+			comp[i].writeSparse(world.engine, id)
+		}
+	}
+}
+
+// Creates n new ids and adds them to the archetype of the components. Returns the ids, the archetype and the index of the first id in the archetype
+// Note: The comps list gets sorted
+func (world *World) spawnBatch(n int, comps []CompId) ([]Id, *archetype, int) {
+	sort.Slice(comps, func(i, j int) bool {
+		return comps[i] < comps[j]
+	})
+	for i := range comps {
+		if world.engine.getSparseStorage(comps[i]) != nil {
+			panic("ecs: Can't spawn sparse components in a batch")
+		}
+	}
+	for i := 1; i < len(comps); i++ {
+		if comps[i-1] == comps[i] {
+			panic("ecs: Can't spawn an entity with the same component type twice")
+		}
+	}
+
+	// Note: The ids are only reserved once the components are valid, so that a panic doesn't leak them
+	ids := make([]Id, n)
+	for i := range ids {
+		ids[i] = world.NewId()
+	}
+	archId := world.engine.dcr.getArchetypeId(comps)
+	start := world.engine.allocateBatch(archId, ids)
+	return ids, world.engine.getArchetype(archId), start
+}
+
+// Removes the component of type T from the entity specified at id. The entity is moved to the archetype of its remaining components.
+// Returns true if the entity exists, else returns false.
+// This API has the same loop caveats as Write.
+func Remove[T any](world *World, id Id) bool {
+	return world.removeIds(id, nameTyped[T]())
+}
+
+// Removes all of the components from the entity specified at id. The components are specified by passing in a value of their type, for example: world.Remove(id, Position{}, Velocity{})
+// Components can also be specified by their CompId, which is how dynamic components are removed
+// Returns true if the entity exists, else returns false.
+func (world *World) Remove(id Id, comp ...any) bool {
+	ids := make([]CompId, len(comp))
+	for i := range comp {
+		ids[i] = compIdOf(comp[i])
+	}
+	return world.removeIds(id, ids...)
+}
+
+func (world *World) removeIds(id Id, comp ...CompId) bool {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
+		return false
+	}
+	if len(comp) <= 0 {
+		return true // Do nothing if there are no components
+	}
+
+	if len(world.hooks) > 0 {
+		world.fireRemoveHooks(id, uniqueComps(comp)) // A component that is listed twice still only gets removed once
+
+		// The hooks may have changed the entity
+		loc = world.engine.locs.get(id)
+		if loc == nil {
+			return true
+		}
+	}
+
+	if len(world.engine.sparse) > 0 {
+		// Sparse components are removed from their sets, the rest move the entity to a new archetype
+		dense := make([]CompId, 0, len(comp))
+		for _, c := range comp {
+			if s := world.engine.getSparseStorage(c); s != nil {
+				s.remove(id)
+			} else {
+				dense = append(dense, c)
+			}
+		}
+		comp = dense
+	}
+
+	world.engine.removeArch(loc.archId, id, comp...)
+	return true
+}
+
 // Reads a specific component of the entity specified at id.
 // Returns true if the entity was found and had that component, else returns false.
 // Deprecated: This API is tentative, I'm trying to improve the QueryN construct so that it can capture this usecase.
 func Read[T any](world *World, id Id) (T, bool) {
 	var ret T
-	archId, ok := world.arch[id]
-	if !ok {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
 		return ret, false
 	}
 
-	return readArch[T](world.engine, archId, id)
+	if s := getSparse[T](world.engine, nameTyped[T]()); s != nil {
+		comp := s.get(id)
+		if comp == nil {
+			return ret, false
+		}
+		return *comp, true
+	}
+
+	return readArch[T](world.engine, loc.archId, id)
 }
 
 // Reads a pointer to the component of the entity at the specified id.
@@ -145,12 +311,16 @@ func Read[T any](world *World, id Id) (T, bool) {
 // This pointer is short lived and can become invalid if any other entity changes in the world
 // Deprecated: This API is tentative, I'm trying to improve the QueryN construct so that it can capture this usecase.
 func ReadPtr[T any](world *World, id Id) *T {
-	archId, ok := world.arch[id]
-	if !ok {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
 		return nil
 	}
 
-	return readPtrArch[T](world.engine, archId, id)
+	if s := getSparse[T](world.engine, nameTyped[T]()); s != nil {
+		return s.get(id)
+	}
+
+	return readPtrArch[T](world.engine, loc.archId, id)
 }
 
 // This is safe for maps and loops
@@ -162,21 +332,103 @@ func ReadPtr[T any](world *World, id Id) *T {
 // This can be called inside maps and loops, it will delete the entity immediately.
 // Returns true if the entity exists and was actually deleted, else returns false
 func Delete(world *World, id Id) bool {
-	archId, ok := world.arch[id]
-	if !ok {
+	loc := world.engine.locs.get(id)
+	if loc == nil {
 		return false
 	}
 
-	delete(world.arch, id)
+	if len(world.hooks) > 0 && !world.isDeleting(id) {
+		// Track the id while its hooks run, so that a hook which deletes the same entity doesn't fire them again
+		world.deleting = append(world.deleting, id)
+		comps := world.engine.getArchetype(loc.archId).comps
+		if len(world.engine.sparse) > 0 {
+			comps = append(world.engine.sparseComps(id), comps...)
+		}
+		world.fireRemoveHooks(id, comps)
+		world.deleting = world.deleting[:len(world.deleting)-1]
+
+		// The hooks may have changed or deleted the entity
+		loc = world.engine.locs.get(id)
+		if loc == nil {
+			return true
+		}
+	}
 
-	world.engine.TagForDeletion(archId, id)
+	world.engine.TagForDeletion(loc.archId, id)
+	world.engine.removeSparse(id)
 	// Note: This was the old, more direct way, but isn't loop safe
 	// - world.engine.DeleteAll(archId, id)
+
+	world.free(id)
 	return true
 }
 
+// Deletes every entity in the world. The archetypes, the capacity of their columns and every view stay valid, so the world can be refilled without allocating everything again.
+// The OnRemove hooks are fired for every entity. Resources are kept. This must not be called inside of maps and loops
+func (world *World) Clear() {
+	if len(world.hooks) > 0 {
+		world.fireClearHooks()
+	}
+
+	for _, arch := range world.engine.dcr.archetypes {
+		for _, id := range arch.lookup.id {
+			if id == InvalidEntity {
+				continue // Skip if its a hole
+			}
+			world.engine.locs.slot(id).alive = false
+			world.free(id)
+		}
+	}
+	world.engine.clear()
+}
+
+// Fires the OnRemove hooks for every entity in the world
+func (world *World) fireClearHooks() {
+	// Note: Copy the ids out first, because the hooks might change the archetypes
+	ids := make([]Id, 0)
+	for _, arch := range world.engine.dcr.archetypes {
+		for _, id := range arch.lookup.id {
+			if id != InvalidEntity {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	for _, id := range ids {
+		loc := world.engine.locs.get(id)
+		if loc == nil {
+			continue
+		}
+		comps := world.engine.getArchetype(loc.archId).comps
+		if len(world.engine.sparse) > 0 {
+			comps = append(world.engine.sparseComps(id), comps...)
+		}
+		world.fireRemoveHooks(id, comps)
+	}
+}
+
+// Clears the world and restarts NewId from the beginning of the Id range.
+// The indices are recycled with their next generation, so Ids from before the reset are still rejected as stale
+func (world *World) Reset() {
+	world.Clear()
+
+	// Note: Every index that NewId handed out is free now. They are pushed in reverse, so that NewId pops the lowest index first.
+	// The reserved generation may already have been handed out by NewId without being written, so the index moves past it
+	world.freeIds = world.freeIds[:0]
+	for index := world.nextId; index > world.minId; {
+		index--
+		slot := world.engine.locs.slotAlloc(index)
+		slot.id = newId(index.Index(), slot.id.Generation()+1)
+		world.freeIds = append(world.freeIds, slot.id)
+	}
+}
+
 // Returns true if the entity exists in the world else it returns false
 func (world *World) Exists(id Id) bool {
-	_, ok := world.arch[id]
-	return ok
+	return world.engine.locs.get(id) != nil
+}
+
+// Returns true if the id refers to a live entity. Ids of deleted entities are never alive again, even after their index gets recycled
+func (world *World) IsAlive(id Id) bool {
+	return world.Exists(id)
 }
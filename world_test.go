@@ -124,6 +124,34 @@ func TestWorldReadMultiWrite(t *testing.T) {
 	compare(t, radOut, rad)
 }
 
+func TestWorldWriteDuplicateComponents(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 3)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{1, 1, 1}), C(velocity{}), C(position{2, 2, 2}))
+	}
+
+	// The last write wins
+	pos, ok := Read[position](world, ids[0])
+	check(t, ok)
+	compare(t, pos, position{2, 2, 2})
+	compare(t, len(world.engine.getArchetype(world.engine.locs.get(ids[0]).archId).comps), 2)
+
+	// Every column of the archetype exists, so everything that walks the columns works
+	check(t, ReadEntity(world, ids[0]) != nil)
+	compare(t, len(Inspect(world, ids[0])), 2)
+	clone := Clone(world, ids[0])
+	pos, _ = Read[position](world, clone)
+	compare(t, pos, position{2, 2, 2})
+
+	Delete(world, ids[1])
+	world.Compact()
+	pos, ok = Read[position](world, ids[2])
+	check(t, ok)
+	compare(t, pos, position{2, 2, 2})
+}
+
 func TestWorldWriteDelete(t *testing.T) {
 	world := NewWorld()
 	ids := make([]Id, 0)
@@ -182,3 +210,374 @@ func TestWorldWriteDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestWorldRemove(t *testing.T) {
+	world := NewWorld()
+	id := world.NewId()
+
+	pos := position{1, 1, 1}
+	vel := velocity{2, 2, 2}
+	Write(world, id, C(pos), C(vel))
+
+	// Remove velocity
+	check(t, Remove[velocity](world, id))
+	posOut, ok := Read[position](world, id)
+	check(t, ok)
+	compare(t, posOut, pos)
+	_, ok = Read[velocity](world, id)
+	check(t, !ok)
+
+	compare(t, world.engine.count(position{}), 1)
+	compare(t, world.engine.count(position{}, velocity{}), 0)
+
+	// Removing a component that isn't there does nothing
+	check(t, Remove[acceleration](world, id))
+	posOut, ok = Read[position](world, id)
+	check(t, ok)
+	compare(t, posOut, pos)
+
+	// Removing the last component leaves an empty entity that still exists
+	check(t, world.Remove(id, position{}))
+	check(t, world.Exists(id))
+	_, ok = Read[position](world, id)
+	check(t, !ok)
+	compare(t, world.engine.count(position{}), 0)
+
+	// The entity can be written to again
+	Write(world, id, C(vel))
+	velOut, ok := Read[velocity](world, id)
+	check(t, ok)
+	compare(t, velOut, vel)
+
+	// Removing from an entity that doesn't exist fails
+	check(t, !Remove[velocity](world, world.NewId()))
+}
+
+func TestWorldIdGenerations(t *testing.T) {
+	world := NewWorld()
+	query := Query1[position](world)
+
+	id := world.NewId()
+	Write(world, id, C(position{1, 1, 1}))
+	check(t, world.IsAlive(id))
+	compare(t, id.Generation(), uint32(0))
+
+	check(t, Delete(world, id))
+	check(t, !world.IsAlive(id))
+	check(t, !Delete(world, id))
+
+	// The index gets recycled with the next generation
+	newId := world.NewId()
+	compare(t, newId.Index(), id.Index())
+	compare(t, newId.Generation(), uint32(1))
+	check(t, newId != id)
+
+	Write(world, newId, C(position{2, 2, 2}))
+	check(t, world.IsAlive(newId))
+
+	// The stale id must not resolve to the new entity
+	check(t, !world.IsAlive(id))
+	check(t, !world.Exists(id))
+	_, ok := Read[position](world, id)
+	check(t, !ok)
+	check(t, ReadPtr[position](world, id) == nil)
+	compare(t, query.Read(id), nil)
+
+	// Writing to the stale id does nothing
+	Write(world, id, C(position{3, 3, 3}))
+	posOut, ok := Read[position](world, newId)
+	check(t, ok)
+	compare(t, posOut, position{2, 2, 2})
+	compare(t, world.engine.count(position{}), 1)
+}
+
+func TestWorldIdRangeExhaustion(t *testing.T) {
+	world := NewWorld()
+	world.SetIdRange(10, 11)
+
+	a := world.NewId()
+	b := world.NewId()
+	compare(t, a.Index(), uint32(10))
+	compare(t, b.Index(), uint32(11))
+	Write(world, a, C(position{}))
+	Write(world, b, C(position{}))
+
+	// Deleted ids are recycled instead of wrapping around
+	Delete(world, a)
+	c := world.NewId()
+	compare(t, c.Index(), a.Index())
+	check(t, c != a)
+
+	func() {
+		defer func() {
+			check(t, recover() != nil)
+		}()
+		world.NewId()
+	}()
+
+	// Ranges past MaxEntity would hand out Ids with generation bits, which alias the low indices
+	for _, r := range [][2]Id{{MaxEntity - 1, MaxEntity + 5}, {newId(10, 1), newId(11, 1)}} {
+		func() {
+			defer func() {
+				check(t, recover() != nil)
+			}()
+			world.SetIdRange(r[0], r[1])
+		}()
+	}
+	world.SetIdRange(MaxEntity-1, MaxEntity)
+	compare(t, world.NewId().Index(), uint32(MaxEntity-1))
+}
+
+func TestWorldArchetypeTransitions(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 0)
+	for i := 0; i < 1000; i++ {
+		id := world.NewId()
+		v := float64(id)
+		Write(world, id, C(position{v, v, v}))
+		ids = append(ids, id)
+	}
+
+	// Toggle velocity on and off several times, so that the cached edges get used
+	for round := 0; round < 3; round++ {
+		for _, id := range ids {
+			v := float64(id)
+			Write(world, id, C(velocity{v, v, v}))
+		}
+		compare(t, world.engine.count(position{}, velocity{}), len(ids))
+
+		for i, id := range ids {
+			if i%2 == 0 {
+				Remove[velocity](world, id)
+			}
+		}
+		compare(t, world.engine.count(position{}, velocity{}), len(ids)/2)
+
+		for i, id := range ids {
+			v := float64(id)
+			posOut, ok := Read[position](world, id)
+			check(t, ok)
+			compare(t, posOut, position{v, v, v})
+
+			velOut, ok := Read[velocity](world, id)
+			check(t, ok == (i%2 != 0))
+			if ok {
+				compare(t, velOut, velocity{v, v, v})
+			}
+		}
+	}
+}
+
+func TestWorldSparseIdRange(t *testing.T) {
+	world := NewWorld()
+	world.SetIdRange(1<<30, MaxEntity)
+	query := Query2[position, velocity](world)
+
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		id := world.NewId()
+		v := float64(i)
+		Write(world, id, C(position{v, v, v}), C(velocity{v, v, v}))
+		ids = append(ids, id)
+	}
+
+	// Also write a user managed id outside of the range
+	userId := Id(5)
+	Write(world, userId, C(position{5, 5, 5}))
+
+	for i, id := range ids {
+		v := float64(i)
+		pos, vel := query.Read(id)
+		compare(t, *pos, position{v, v, v})
+		compare(t, *vel, velocity{v, v, v})
+	}
+	pos, vel := query.Read(userId)
+	compare(t, *pos, position{5, 5, 5})
+	compare(t, vel, nil)
+
+	// User managed ids can be deleted and rewritten
+	check(t, Delete(world, userId))
+	check(t, !world.Exists(userId))
+	Write(world, userId, C(position{6, 6, 6}))
+	pos, _ = query.Read(userId)
+	compare(t, *pos, position{6, 6, 6})
+}
+
+func TestWorldIncrementalViews(t *testing.T) {
+	world := NewWorld()
+	query := Query1[position](world)
+
+	// Only the empty archetype exists at first
+	compare(t, len(world.engine.dcr.archetypes), 1)
+
+	Write(world, world.NewId(), C(position{}), C(velocity{}), C(acceleration{}))
+
+	// Archetypes are only created for component sets that entities actually have, not for every prefix in the trie
+	compare(t, len(world.engine.dcr.archetypes), 2)
+
+	Write(world, world.NewId(), C(position{}))
+	Write(world, world.NewId(), C(velocity{}))
+	compare(t, len(world.engine.dcr.archetypes), 4)
+
+	count := 0
+	query.MapId(func(id Id, p *position) {
+		count++
+	})
+	compare(t, count, 2)
+	compare(t, len(query.filter.archIds), 2)
+	compare(t, query.filter.checked, 4)
+
+	// Newly created archetypes get picked up by existing views
+	Write(world, world.NewId(), C(position{}), C(radius{}))
+	count = 0
+	query.MapId(func(id Id, p *position) {
+		count++
+	})
+	compare(t, count, 3)
+	compare(t, len(query.filter.archIds), 3)
+}
+
+type player struct{}
+
+func TestWorldTags(t *testing.T) {
+	world := NewWorld()
+
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		id := world.NewId()
+		if i%2 == 0 {
+			Write(world, id, C(position{float64(i), 0, 0}), C(player{}))
+		} else {
+			Write(world, id, C(position{float64(i), 0, 0}))
+		}
+		ids = append(ids, id)
+	}
+
+	// Tags don't store anything per entity
+	loc := world.engine.locs.get(ids[0])
+	arch := world.engine.getArchetype(loc.archId)
+	_, ok := arch.columns[arch.columnIndex(nameTyped[player]())].(*tagColumn[player])
+	check(t, ok)
+
+	// Tags can be used as a filter
+	count := 0
+	Query1[position](world, With(player{})).MapId(func(id Id, p *position) {
+		check(t, int(p.x)%2 == 0)
+		count++
+	})
+	compare(t, count, 5)
+
+	// Or be queried like any other component
+	count = 0
+	Query2[position, player](world, Optional(player{})).MapId(func(id Id, p *position, pl *player) {
+		if pl != nil {
+			count++
+		}
+	})
+	compare(t, count, 5)
+
+	tag, ok := Read[player](world, ids[0])
+	check(t, ok)
+	compare(t, tag, player{})
+	_, ok = Read[player](world, ids[1])
+	check(t, !ok)
+	compare(t, len(world.engine.ReadEntity(loc.archId, ids[0]).comp), 2)
+
+	// Tags move along with the entity and can be removed
+	Write(world, ids[0], C(velocity{1, 1, 1}))
+	_, ok = Read[player](world, ids[0])
+	check(t, ok)
+	check(t, Remove[player](world, ids[0]))
+	_, ok = Read[player](world, ids[0])
+	check(t, !ok)
+	pos, ok := Read[position](world, ids[0])
+	check(t, ok)
+	compare(t, pos, position{0, 0, 0})
+
+	Delete(world, ids[2])
+	world.Compact()
+	count = 0
+	Query1[player](world).MapSlices(func(id []Id, pl []player) {
+		compare(t, len(id), len(pl))
+		count += len(id)
+	})
+	compare(t, count, 3)
+}
+
+func TestWorldClearReset(t *testing.T) {
+	world := NewWorld()
+	RegisterSparse[selected](world)
+	query := Query2[position, velocity](world)
+
+	ids := make([]Id, 0)
+	for i := 0; i < 100; i++ {
+		id := world.NewId()
+		Write(world, id, C(position{}), C(velocity{}), C(selected{}))
+		ids = append(ids, id)
+	}
+	Write(world, world.NewId(), C(radius{}))
+	Delete(world, ids[0])
+
+	removed := 0
+	OnRemove(world, func(id Id, p position) {
+		removed++
+	})
+
+	archetypes := len(world.engine.dcr.archetypes)
+	loc := world.engine.locs.get(ids[1])
+	arch := world.engine.getArchetype(loc.archId)
+	capacity := cap(getColumn[position](arch, nameTyped[position]()).comp)
+
+	world.Clear()
+	compare(t, removed, 99)
+
+	for _, id := range ids {
+		check(t, !world.Exists(id))
+		_, ok := Read[selected](world, id)
+		check(t, !ok)
+	}
+	compare(t, len(world.engine.dcr.archetypes), archetypes)
+	compare(t, len(arch.lookup.id), 0)
+	compare(t, cap(getColumn[position](arch, nameTyped[position]()).comp), capacity)
+
+	count := 0
+	query.MapId(func(id Id, p *position, v *velocity) {
+		count++
+	})
+	compare(t, count, 0)
+
+	// Recycled ids don't alias the cleared entities
+	id := world.NewId()
+	check(t, id != ids[len(ids)-1])
+	Write(world, id, C(position{}), C(velocity{}))
+	check(t, !world.Exists(ids[len(ids)-1]))
+	query.MapId(func(id Id, p *position, v *velocity) {
+		count++
+	})
+	compare(t, count, 1)
+
+	// Reset restarts the ids from the beginning, with a new generation
+	unwritten := world.NewId()
+	world.Reset()
+	check(t, !world.Exists(id))
+	reused := world.NewId()
+	compare(t, reused.Index(), ids[0].Index())
+	check(t, reused != ids[0])
+
+	// So Ids from before the reset don't alias the new entities
+	Write(world, reused, C(position{}))
+	for _, old := range []Id{ids[0], ids[1], id, unwritten} {
+		check(t, !world.Exists(old))
+		_, ok := Read[position](world, old)
+		check(t, !ok)
+	}
+	Write(world, ids[1], C(position{}))
+	check(t, !world.Exists(ids[1]))
+	for {
+		next := world.NewId()
+		if next.Index() == unwritten.Index() {
+			check(t, next != unwritten)
+			break
+		}
+	}
+}